@@ -25,6 +25,17 @@ func (c *Comparator[T]) Is(value T) *Comparator[T] {
 	return &Comparator[T]{c.fns, &value}
 }
 
+// Compare returns a negative integer, zero, or a positive integer as the
+// value to compare is less than, equal to, or greater than the value.
+//
+// # Example
+//
+//	assert.Equal(t, -1, cmp.Is(1).Compare(2))
+//	assert.Equal(t, 0, cmp.Is(1).Compare(1))
+func (c *Comparator[T]) Compare(value T) int {
+	return c.compare(*c.value, value)
+}
+
 // Equal returns true if the value is equal to the value to compare.
 //
 // # Example
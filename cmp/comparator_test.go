@@ -111,3 +111,15 @@ func TestCompare(t *testing.T) {
 	assert.True(t, Is(c).Less(&comp[int]{2}))
 	assert.True(t, Is(c).LessEqual(&comp[int]{1}))
 }
+
+func TestComparatorCompare(t *testing.T) {
+	lengths := By(func(value, other string) int {
+		return len(value) - len(other)
+	})
+
+	assert.Equal(t, -1, Is(1).Compare(2))
+	assert.Equal(t, 0, Is(1).Compare(1))
+	assert.Equal(t, 1, Is(2).Compare(1))
+	assert.Equal(t, -1, Is("a").Compare("b"))
+	assert.Equal(t, 0, lengths.Is("ab").Compare("cd"))
+}
@@ -2,12 +2,14 @@ module github.com/skylissh/std-go
 
 go 1.19
 
-require golang.org/x/exp v0.0.0-20221205204356-47842c84f3db
+require (
+	github.com/stretchr/testify v1.8.1
+	golang.org/x/exp v0.0.0-20221205204356-47842c84f3db
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/stretchr/objx v0.5.0 // indirect
-	github.com/stretchr/testify v1.8.1 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,70 @@
+package itertools
+
+import (
+	"context"
+
+	"github.com/skylissh/std-go/itertools/iters"
+)
+
+// Returns an iterator that receives the values of the channel, and is
+// exhausted when the channel is closed.
+//
+// The values can only be received once, so the iterator can't be cloned.
+//
+// # Example
+//
+//	ch := make(chan int)
+//	go func() {
+//		defer close(ch)
+//		ch <- 1
+//		ch <- 2
+//	}()
+//
+//	assert.Equal(t, []int{1, 2}, itertools.FromChan(ch).Collect())
+func FromChan[T any](ch <-chan T) *iters.Chan[T] {
+	return iters.NewChan(ch)
+}
+
+// Sends the values of the iterator to a new channel with the given buffer
+// size, from a new goroutine.
+//
+// The channel is closed when the iterator is exhausted, or when the context
+// is done. In the latter case the iterator is not advanced anymore, and the
+// goroutine exits even if nobody is receiving.
+//
+// # Example
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//
+//	for v := range itertools.ToChan[int](ctx, itertools.Range(0, 3, 1), 0) {
+//		fmt.Println(v)
+//	}
+func ToChan[T any](ctx context.Context, iter iters.Iterable[T], buf uint) <-chan T {
+	ch := make(chan T, buf)
+
+	go func() {
+		defer close(ch)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			v := iter.Next()
+			if v == nil {
+				return
+			}
+
+			select {
+			case ch <- *v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
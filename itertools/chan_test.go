@@ -0,0 +1,58 @@
+package itertools_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int)
+
+	go func() {
+		defer close(ch)
+
+		for i := 1; i <= 3; i++ {
+			ch <- i
+		}
+	}()
+
+	assert.Equal(t, []int{1, 2, 3}, itertools.FromChan(ch).Collect())
+}
+
+func TestToChan(t *testing.T) {
+	values := make([]int, 0)
+
+	for v := range itertools.ToChan[int](context.Background(), itertools.Range(0, 5, 1), 2) {
+		values = append(values, v)
+	}
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, values)
+}
+
+func TestToChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := itertools.ToChan[int](ctx, itertools.Count(0), 0)
+
+	assert.Equal(t, 0, <-ch)
+	assert.Equal(t, 1, <-ch)
+	cancel()
+
+	// The goroutine may have sent one more value before seeing the
+	// cancellation, but the channel must be closed after it.
+	count := 0
+	for range ch {
+		count++
+	}
+
+	assert.LessOrEqual(t, count, 1)
+}
+
+func TestChanRoundTrip(t *testing.T) {
+	ch := itertools.ToChan[int](context.Background(), itertools.Range(0, 10, 1), 0)
+	evens := itertools.FromChan(ch).Filter(func(v int) bool { return v%2 == 0 })
+
+	assert.Equal(t, []int{0, 2, 4, 6, 8}, evens.Collect())
+}
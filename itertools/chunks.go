@@ -0,0 +1,65 @@
+package itertools
+
+import "github.com/skylissh/std-go/itertools/iters"
+
+// Returns an iterator that yields slices with n values of the original
+// iterator. The last slice may have fewer than n values.
+//
+// Panics if n is 0.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4, 5})
+//
+//	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, itertools.Chunks[int](iter, 2).Collect())
+func Chunks[T any](iter iters.Iterable[T], n uint) *iters.Chunks[T] {
+	return iters.NewChunks(iter, n, false)
+}
+
+// Returns an iterator that yields slices with exactly n values of the
+// original iterator. The remaining values that don't fill a slice are dropped.
+//
+// Panics if n is 0.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4, 5})
+//
+//	assert.Equal(t, [][]int{{1, 2}, {3, 4}}, itertools.ChunksExact[int](iter, 2).Collect())
+func ChunksExact[T any](iter iters.Iterable[T], n uint) *iters.Chunks[T] {
+	return iters.NewChunks(iter, n, true)
+}
+
+// Returns an iterator that yields overlapping slices with n values of the
+// original iterator, advancing one value each time.
+//
+// Each window is a new slice, use WindowsView to reuse the same one.
+// Panics if n is 0.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4, 5})
+//
+//	assert.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, itertools.Windows[int](iter, 3).Collect())
+func Windows[T any](iter iters.Iterable[T], n uint) *iters.Windows[T] {
+	return iters.NewWindows(iter, n)
+}
+
+// Returns an iterator that yields overlapping slices with n values of the
+// original iterator, like Windows, but without allocating a slice per window.
+//
+// The yielded slice is overwritten by the next call to Next, so it can only
+// be used through Next or adapters that consume each window right away, like
+// Map. Panics if n is 0.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4})
+//	sums := itertools.Map[[]int](itertools.WindowsView[int](iter, 2), func(w []int) int {
+//		return w[0] + w[1]
+//	})
+//
+//	assert.Equal(t, []int{3, 5, 7}, sums.Collect())
+func WindowsView[T any](iter iters.Iterable[T], n uint) *iters.WindowsView[T] {
+	return iters.NewWindowsView(iter, n)
+}
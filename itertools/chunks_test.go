@@ -0,0 +1,24 @@
+package itertools_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindowsCollect(t *testing.T) {
+	iter := itertools.AsIter([]int{1, 2, 3, 4, 5})
+	expect := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+
+	assert.Equal(t, expect, itertools.Windows[int](iter, 3).Collect())
+}
+
+func TestWindowsView(t *testing.T) {
+	iter := itertools.AsIter([]int{1, 2, 3, 4})
+	sums := itertools.Map[[]int](itertools.WindowsView[int](iter, 2), func(w []int) int {
+		return w[0] + w[1]
+	})
+
+	assert.Equal(t, []int{3, 5, 7}, sums.Collect())
+}
@@ -0,0 +1,193 @@
+package itertools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/skylissh/std-go/itertools/iters"
+)
+
+// Consumes the iterator into the collector, and returns its result.
+//
+// The collectors are single-use, as they keep the values they received, so
+// pass a new one to each call.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"a", "b", "c"})
+//
+//	assert.Equal(t, "a, b, c", itertools.CollectInto[string](iter, itertools.Join[string](", ")))
+func CollectInto[T, R any](iter iters.Iterable[T], collector iters.Collector[T, R]) R {
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		collector.Add(*v)
+	}
+
+	return collector.Result()
+}
+
+// OnCollision is the policy of ToMap when two values have the same key.
+type OnCollision int
+
+const (
+	// Overwrites the previous entry, so the last value with the key wins.
+	KeepLast OnCollision = iota
+	// Ignores the repeated keys, so the first value with the key wins.
+	KeepFirst
+	// Panics when a key is repeated.
+	PanicOnCollision
+)
+
+// Returns a collector that builds a map with the key and the value computed
+// for each value, resolving repeated keys with the given policy.
+//
+// # Example
+//
+//	people := itertools.AsIter([]Person{{"Alice", 30}, {"Bob", 25}})
+//	ages := itertools.CollectInto[Person](people, itertools.ToMap(
+//		func(p Person) string { return p.Name },
+//		func(p Person) int { return p.Age },
+//		itertools.PanicOnCollision,
+//	))
+//
+//	assert.Equal(t, map[string]int{"Alice": 30, "Bob": 25}, ages)
+func ToMap[T any, K comparable, V any](key func(value T) K, value func(value T) V, policy OnCollision) iters.Collector[T, map[K]V] {
+	m := make(map[K]V)
+
+	return &collector[T, map[K]V]{
+		func(v T) {
+			k := key(v)
+
+			if _, ok := m[k]; ok {
+				switch policy {
+				case KeepFirst:
+					return
+				case PanicOnCollision:
+					panic(fmt.Sprintf("The key %v is repeated", k))
+				}
+			}
+
+			m[k] = value(v)
+		},
+		func() map[K]V { return m },
+	}
+}
+
+// Returns a collector that builds a set with the values.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 1})
+//
+//	assert.Equal(t, map[int]struct{}{1: {}, 2: {}}, itertools.CollectInto[int](iter, itertools.ToSet[int]()))
+func ToSet[T comparable]() iters.Collector[T, map[T]struct{}] {
+	set := make(map[T]struct{})
+
+	return &collector[T, map[T]struct{}]{
+		func(v T) { set[v] = struct{}{} },
+		func() map[T]struct{} { return set },
+	}
+}
+
+// Returns a collector that concatenates the values with the separator.
+//
+// Strings are used as they are, values implementing fmt.Stringer use their
+// String method, and other values are formatted with fmt.Sprint.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"a", "b", "c"})
+//
+//	assert.Equal(t, "a, b, c", itertools.CollectInto[string](iter, itertools.Join[string](", ")))
+func Join[T any](sep string) iters.Collector[T, string] {
+	var builder strings.Builder
+	first := true
+
+	return &collector[T, string]{
+		func(v T) {
+			if !first {
+				builder.WriteString(sep)
+			}
+
+			first = false
+			builder.WriteString(fmt.Sprint(v))
+		},
+		builder.String,
+	}
+}
+
+// Returns a collector that splits the values in two slices, the first one
+// with the values that match the predicate, and the second one with the rest.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4})
+//	evens, odds := itertools.CollectInto[int](iter, itertools.Partition(func(v int) bool {
+//		return v%2 == 0
+//	})).Unpack()
+//
+//	assert.Equal(t, []int{2, 4}, evens)
+//	assert.Equal(t, []int{1, 3}, odds)
+func Partition[T any](predicate func(value T) bool) iters.Collector[T, iters.Pair[[]T, []T]] {
+	matches, rest := make([]T, 0), make([]T, 0)
+
+	return &collector[T, iters.Pair[[]T, []T]]{
+		func(v T) {
+			if predicate(v) {
+				matches = append(matches, v)
+			} else {
+				rest = append(rest, v)
+			}
+		},
+		func() iters.Pair[[]T, []T] { return iters.NewPair(matches, rest) },
+	}
+}
+
+// Returns a collector that counts how many values have each key.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"apple", "avocado", "banana"})
+//	counts := itertools.CollectInto[string](iter, itertools.CountBy(func(v string) byte {
+//		return v[0]
+//	}))
+//
+//	assert.Equal(t, map[byte]int{'a': 2, 'b': 1}, counts)
+func CountBy[T any, K comparable](key func(value T) K) iters.Collector[T, map[K]int] {
+	counts := make(map[K]int)
+
+	return &collector[T, map[K]int]{
+		func(v T) { counts[key(v)]++ },
+		func() map[K]int { return counts },
+	}
+}
+
+// Returns a collector that appends the values to a slice with an initial
+// capacity of n, to avoid reallocations when the number of values is known.
+//
+// # Example
+//
+//	iter := itertools.Range(0, 1000, 1)
+//	values := itertools.CollectInto[int](iter, itertools.ToSliceCap[int](1000))
+func ToSliceCap[T any](n uint) iters.Collector[T, []T] {
+	values := make([]T, 0, n)
+
+	return &collector[T, []T]{
+		func(v T) { values = append(values, v) },
+		func() []T { return values },
+	}
+}
+
+// A Collector built from the functions that receive the values and return
+// the result, used by all the built-in collectors.
+type collector[T, R any] struct {
+	add    func(T)
+	result func() R
+}
+
+func (c *collector[T, R]) Add(value T) {
+	c.add(value)
+}
+
+func (c *collector[T, R]) Result() R {
+	return c.result()
+}
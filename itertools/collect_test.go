@@ -0,0 +1,87 @@
+package itertools_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToMap(t *testing.T) {
+	people := itertools.AsIter([]person{{"Alice", 30}, {"Bob", 25}, {"Alice", 40}})
+	ages := itertools.CollectInto[person](people, itertools.ToMap(
+		func(p person) string { return p.name },
+		func(p person) int { return p.age },
+		itertools.KeepFirst,
+	))
+
+	assert.Equal(t, map[string]int{"Alice": 30, "Bob": 25}, ages)
+}
+
+func TestToMapKeepLast(t *testing.T) {
+	people := itertools.AsIter([]person{{"Alice", 30}, {"Alice", 40}})
+	ages := itertools.CollectInto[person](people, itertools.ToMap(
+		func(p person) string { return p.name },
+		func(p person) int { return p.age },
+		itertools.KeepLast,
+	))
+
+	assert.Equal(t, map[string]int{"Alice": 40}, ages)
+}
+
+func TestToMapPanic(t *testing.T) {
+	people := itertools.AsIter([]person{{"Alice", 30}, {"Alice", 40}})
+
+	assert.PanicsWithValue(t, "The key Alice is repeated", func() {
+		itertools.CollectInto[person](people, itertools.ToMap(
+			func(p person) string { return p.name },
+			func(p person) int { return p.age },
+			itertools.PanicOnCollision,
+		))
+	})
+}
+
+func TestToSet(t *testing.T) {
+	set := itertools.ToSet[int]()
+	itertools.AsIter([]int{1, 2, 1}).Drain(set)
+
+	assert.Equal(t, map[int]struct{}{1: {}, 2: {}}, set.Result())
+}
+
+func TestJoin(t *testing.T) {
+	iter := itertools.AsIter([]string{"a", "b", "c"})
+
+	assert.Equal(t, "a, b, c", itertools.CollectInto[string](iter, itertools.Join[string](", ")))
+	assert.Equal(t, "", itertools.CollectInto[string](itertools.AsIter([]string{}), itertools.Join[string](", ")))
+}
+
+func TestJoinStringer(t *testing.T) {
+	iter := itertools.AsIter([]time.Duration{time.Second, time.Minute})
+
+	assert.Equal(t, "1s-1m0s", itertools.CollectInto[time.Duration](iter, itertools.Join[time.Duration]("-")))
+}
+
+func TestPartition(t *testing.T) {
+	iter := itertools.AsIter([]int{1, 2, 3, 4})
+	evens, odds := itertools.CollectInto[int](iter, itertools.Partition(isEven)).Unpack()
+
+	assert.Equal(t, []int{2, 4}, evens)
+	assert.Equal(t, []int{1, 3}, odds)
+}
+
+func TestCountBy(t *testing.T) {
+	iter := itertools.AsIter([]string{"apple", "avocado", "banana"})
+	counts := itertools.CollectInto[string](iter, itertools.CountBy(func(v string) byte {
+		return v[0]
+	}))
+
+	assert.Equal(t, map[byte]int{'a': 2, 'b': 1}, counts)
+}
+
+func TestToSliceCap(t *testing.T) {
+	values := itertools.CollectInto[int](itertools.Range(0, 3, 1), itertools.ToSliceCap[int](10))
+
+	assert.Equal(t, []int{0, 1, 2}, values)
+	assert.Equal(t, 10, cap(values))
+}
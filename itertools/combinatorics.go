@@ -0,0 +1,80 @@
+package itertools
+
+import "github.com/skylissh/std-go/itertools/iters"
+
+// Returns an iterator that yields the cartesian product of the values of the
+// iterators, as slices with one value of each iterator. It is equivalent to
+// nested for loops.
+//
+// The iterators are buffered once, and the combinations are generated lazily.
+//
+// # Example
+//
+//	sizes := itertools.AsIter([]string{"S", "M"})
+//	colors := itertools.AsIter([]string{"red", "blue"})
+//	product := itertools.Product[string](sizes, colors)
+//
+//	assert.Equal(t, uint64(4), product.Len())
+//	assert.Equal(t, []string{"S", "red"}, *product.Next())
+//	assert.Equal(t, []string{"S", "blue"}, *product.Next())
+func Product[T any](iterables ...iters.Iterable[T]) *iters.Product[T] {
+	return iters.NewProduct(iterables...)
+}
+
+// Returns an iterator that yields all the ordered arrangements of r values of
+// the iterator.
+//
+// The iterator is buffered once, and the permutations are generated lazily.
+//
+// # Example
+//
+//	permutations := itertools.Permutations[int](itertools.AsIter([]int{1, 2, 3}), 2)
+//
+//	assert.Equal(t, uint64(6), permutations.Len())
+//	assert.Equal(t, [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}}, permutations.Collect())
+func Permutations[T any](iter iters.Iterable[T], r uint) *iters.Permutations[T] {
+	return iters.NewPermutations(iter, r)
+}
+
+// Returns an iterator that yields all the subsets of r values of the
+// iterator, keeping the order of the values.
+//
+// The iterator is buffered once, and the combinations are generated lazily.
+//
+// # Example
+//
+//	combinations := itertools.Combinations[int](itertools.AsIter([]int{1, 2, 3}), 2)
+//
+//	assert.Equal(t, uint64(3), combinations.Len())
+//	assert.Equal(t, [][]int{{1, 2}, {1, 3}, {2, 3}}, combinations.Collect())
+func Combinations[T any](iter iters.Iterable[T], r uint) *iters.Combinations[T] {
+	return iters.NewCombinations(iter, r, false)
+}
+
+// Returns an iterator that yields all the subsets of r values of the
+// iterator, where each value can be repeated, keeping the order of the values.
+//
+// The iterator is buffered once, and the combinations are generated lazily.
+//
+// # Example
+//
+//	combinations := itertools.CombinationsWithReplacement[int](itertools.AsIter([]int{1, 2}), 2)
+//
+//	assert.Equal(t, [][]int{{1, 1}, {1, 2}, {2, 2}}, combinations.Collect())
+func CombinationsWithReplacement[T any](iter iters.Iterable[T], r uint) *iters.Combinations[T] {
+	return iters.NewCombinations(iter, r, true)
+}
+
+// Returns an iterator that yields all the subsets of the values of the
+// iterator, ordered by size.
+//
+// The iterator is buffered once, and the subsets are generated lazily.
+//
+// # Example
+//
+//	powerset := itertools.Powerset[int](itertools.AsIter([]int{1, 2}))
+//
+//	assert.Equal(t, [][]int{{}, {1}, {2}, {1, 2}}, powerset.Collect())
+func Powerset[T any](iter iters.Iterable[T]) *iters.Powerset[T] {
+	return iters.NewPowerset(iter)
+}
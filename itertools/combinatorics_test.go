@@ -0,0 +1,99 @@
+package itertools_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProduct(t *testing.T) {
+	product := itertools.Product[int](itertools.AsIter([]int{1, 2}), itertools.AsIter([]int{3, 4, 5}))
+	expect := [][]int{{1, 3}, {1, 4}, {1, 5}, {2, 3}, {2, 4}, {2, 5}}
+
+	assert.Equal(t, uint64(6), product.Len())
+	assert.Equal(t, expect, product.Collect())
+}
+
+func TestProductEmpty(t *testing.T) {
+	product := itertools.Product[int](itertools.AsIter([]int{1, 2}), itertools.AsIter([]int{}))
+
+	assert.Equal(t, uint64(0), product.Len())
+	assert.Empty(t, product.Collect())
+	assert.Equal(t, [][]int{{}}, itertools.Product[int]().Collect())
+}
+
+func TestPermutations(t *testing.T) {
+	permutations := itertools.Permutations[int](itertools.AsIter([]int{1, 2, 3}), 2)
+	expect := [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}}
+
+	assert.Equal(t, uint64(6), permutations.Len())
+	assert.Equal(t, expect, permutations.Collect())
+}
+
+func TestPermutationsFull(t *testing.T) {
+	permutations := itertools.Permutations[int](itertools.AsIter([]int{1, 2, 3}), 3)
+	expect := [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}
+
+	assert.Equal(t, expect, permutations.Collect())
+	assert.Equal(t, expect, permutations.Clone().Collect())
+}
+
+func TestPermutationsTooLong(t *testing.T) {
+	permutations := itertools.Permutations[int](itertools.AsIter([]int{1, 2}), 3)
+
+	assert.Equal(t, uint64(0), permutations.Len())
+	assert.Empty(t, permutations.Collect())
+}
+
+func TestCombinations(t *testing.T) {
+	combinations := itertools.Combinations[int](itertools.AsIter([]int{1, 2, 3, 4}), 2)
+	expect := [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}
+
+	assert.Equal(t, uint64(6), combinations.Len())
+	assert.Equal(t, expect, combinations.Collect())
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	combinations := itertools.CombinationsWithReplacement[int](itertools.AsIter([]int{1, 2, 3}), 2)
+	expect := [][]int{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}}
+
+	assert.Equal(t, uint64(6), combinations.Len())
+	assert.Equal(t, expect, combinations.Collect())
+}
+
+func TestPowerset(t *testing.T) {
+	powerset := itertools.Powerset[int](itertools.AsIter([]int{1, 2, 3}))
+	expect := [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}
+
+	assert.Equal(t, uint64(8), powerset.Len())
+	assert.Equal(t, expect, powerset.Collect())
+}
+
+func TestLenMatchesCount(t *testing.T) {
+	pool := []int{1, 2, 3, 4, 5}
+
+	for r := uint(0); r <= 6; r++ {
+		permutations := itertools.Permutations[int](itertools.AsIter(pool), r)
+		combinations := itertools.Combinations[int](itertools.AsIter(pool), r)
+		replacement := itertools.CombinationsWithReplacement[int](itertools.AsIter(pool), r)
+
+		assert.Equal(t, int(permutations.Len()), len(permutations.Collect()))
+		assert.Equal(t, int(combinations.Len()), len(combinations.Collect()))
+		assert.Equal(t, int(replacement.Len()), len(replacement.Collect()))
+	}
+}
+
+func TestCombinationsLenLarge(t *testing.T) {
+	fits := itertools.Combinations[int](itertools.Range(0, 66, 1), 33)
+	overflows := itertools.Combinations[int](itertools.Range(0, 68, 1), 34)
+
+	assert.Equal(t, uint64(7219428434016265740), fits.Len())
+	assert.Equal(t, uint64(math.MaxUint64), overflows.Len())
+}
+
+func TestPowersetLenLarge(t *testing.T) {
+	assert.Equal(t, uint64(1)<<63, itertools.Powerset[int](itertools.Range(0, 63, 1)).Len())
+	assert.Equal(t, uint64(math.MaxUint64), itertools.Powerset[int](itertools.Range(0, 64, 1)).Len())
+}
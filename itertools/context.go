@@ -0,0 +1,25 @@
+package itertools
+
+import (
+	"context"
+
+	"github.com/skylissh/std-go/itertools/iters"
+)
+
+// Returns an iterator that yields the values of the original iterator until
+// the context is done. Once it stops, Err returns the error of the context.
+//
+// # Example
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//
+//	lines := itertools.WithContext[string](ctx, source)
+//	lines.ForEach(process)
+//
+//	if err := lines.Err(); err != nil {
+//		return err
+//	}
+func WithContext[T any](ctx context.Context, iter iters.Iterable[T]) *iters.Context[T] {
+	return iters.NewContext(ctx, iter)
+}
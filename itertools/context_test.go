@@ -0,0 +1,70 @@
+package itertools_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	iter := itertools.WithContext[int](ctx, itertools.Count(0))
+
+	assert.Equal(t, []int{0, 1, 2}, iter.Take(3).Collect())
+	cancel()
+
+	assert.Nil(t, iter.Next())
+	assert.ErrorIs(t, iter.Err(), context.Canceled)
+}
+
+func TestWithContextExhausted(t *testing.T) {
+	iter := itertools.WithContext[int](context.Background(), itertools.Range(0, 3, 1))
+
+	assert.Equal(t, []int{0, 1, 2}, iter.Collect())
+	assert.NoError(t, iter.Err())
+}
+
+func TestWithContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	iter := itertools.WithContext[int](ctx, itertools.Repeat(1))
+	iter.ForEach(func(int) { time.Sleep(time.Millisecond) })
+
+	assert.ErrorIs(t, iter.Err(), context.DeadlineExceeded)
+}
+
+func TestForEachCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	seen := 0
+
+	err := itertools.Count(0).ForEachCtx(ctx, func(v int) {
+		seen++
+		if v == 4 {
+			cancel()
+		}
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 5, seen)
+}
+
+func TestCollectCtx(t *testing.T) {
+	values, err := itertools.Range(0, 3, 1).CollectCtx(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2}, values)
+}
+
+func TestCollectCtxCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	values, err := itertools.Count(0).CollectCtx(ctx)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, values)
+}
@@ -4,6 +4,9 @@ import "github.com/skylissh/std-go/itertools/iters"
 
 // Repeats the values from the original iterator indefinitely.
 //
+// The iterator is cloned each time it is exhausted, so it panics if it is not
+// cloneable.
+//
 // # Example
 //
 //		iter := itertools.AsIter([]int{1, 2, 3})
@@ -17,7 +20,7 @@ import "github.com/skylissh/std-go/itertools/iters"
 //		assert.Equal(t, 3, iter.Next())
 //		assert.Equal(t, 1, iter.Next())
 //	 // Endless loop...
-func Cycle[T any, E iters.CloneableIter[T, E]](iter E) *iters.Cycle[T, E] {
-	cycle := iters.NewCycle[T](iter)
+func Cycle[T any](iter iters.Iterable[T]) *iters.Cycle[T] {
+	cycle := iters.NewCycle(iter)
 	return cycle
 }
@@ -0,0 +1,31 @@
+package itertools_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/skylissh/std-go/itertools/iters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCycle(t *testing.T) {
+	cycle := itertools.Cycle[int](itertools.AsIter([]int{1, 2, 3}))
+
+	assert.Equal(t, []int{1, 2, 3, 1, 2, 3, 1}, cycle.Take(7).Collect())
+}
+
+func TestCycleFilter(t *testing.T) {
+	iter := itertools.AsIter([]int{1, 2, 3, 4})
+	cycle := itertools.Cycle[int](iter.Filter(func(v int) bool { return v%2 == 0 }))
+
+	assert.Equal(t, []int{2, 4, 2, 4}, cycle.Take(4).Collect())
+}
+
+func TestCycleFlatMap(t *testing.T) {
+	iter := itertools.AsIter([]int{1, 2})
+	m := itertools.FlatMap[int, int](iter, func(v int) iters.Iterable[int] {
+		return itertools.AsIter([]int{v, v * 10})
+	})
+
+	assert.Equal(t, []int{1, 10, 2, 20, 1, 10}, itertools.Cycle[int](m).Take(6).Collect())
+}
@@ -0,0 +1,58 @@
+package itertools
+
+import "github.com/skylissh/std-go/itertools/iters"
+
+// Returns an iterator that skips the values that are equal to the previous
+// value, using == to compare them.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int64{1, 1, 2, 1, 1})
+//
+//	assert.Equal(t, []int64{1, 2, 1}, itertools.Dedup[int64](iter).Collect())
+func Dedup[T comparable](iter iters.Iterable[T]) *iters.DedupBy[T, T] {
+	return iters.NewDedupBy(iter, identity[T])
+}
+
+// Returns an iterator that skips the values whose key is equal to the key of
+// the previous value. The key of each value is computed once.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"apple", "avocado", "banana", "apricot"})
+//	dedup := itertools.DedupBy[string](iter, func(v string) byte { return v[0] })
+//
+//	assert.Equal(t, []string{"apple", "banana", "apricot"}, dedup.Collect())
+func DedupBy[T any, K comparable](iter iters.Iterable[T], key func(value T) K) *iters.DedupBy[T, K] {
+	return iters.NewDedupBy(iter, key)
+}
+
+// Returns an iterator that skips all the values already seen, keeping them in
+// a set.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 1, 3, 2})
+//
+//	assert.Equal(t, []int{1, 2, 3}, itertools.Unique[int](iter).Collect())
+func Unique[T comparable](iter iters.Iterable[T]) *iters.UniqueBy[T, T] {
+	return iters.NewUniqueBy(iter, identity[T])
+}
+
+// Returns an iterator that skips the values whose key was already seen,
+// keeping the keys in a set.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"a", "B", "A", "b"})
+//
+//	assert.Equal(t, []string{"a", "B"}, itertools.UniqueBy[string](iter, strings.ToLower).Collect())
+func UniqueBy[T any, K comparable](iter iters.Iterable[T], key func(value T) K) *iters.UniqueBy[T, K] {
+	return iters.NewUniqueBy(iter, key)
+}
+
+// Returns the same value, used as the key of the values that are already
+// comparable.
+func identity[T any](value T) T {
+	return value
+}
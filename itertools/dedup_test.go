@@ -0,0 +1,65 @@
+package itertools_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDedup(t *testing.T) {
+	type point struct{ x, y int }
+
+	numbers := itertools.AsIter([]int64{1, 1, 2, 1, 1})
+	points := itertools.AsIter([]point{{1, 2}, {1, 2}, {2, 1}})
+
+	assert.Equal(t, []int64{1, 2, 1}, itertools.Dedup[int64](numbers).Collect())
+	assert.Equal(t, []point{{1, 2}, {2, 1}}, itertools.Dedup[point](points).Collect())
+}
+
+// reused is an iterator that returns the same pointer for every value.
+type reused struct {
+	values  []int
+	current int
+}
+
+func (r *reused) Next() *int {
+	if len(r.values) == 0 {
+		return nil
+	}
+
+	r.current, r.values = r.values[0], r.values[1:]
+	return &r.current
+}
+
+func TestDedupReusedPointer(t *testing.T) {
+	dedup := itertools.Dedup[int](&reused{values: []int{1, 1, 2, 2, 3}})
+
+	assert.Equal(t, []int{1, 2, 3}, dedup.Collect())
+}
+
+func TestDedupBy(t *testing.T) {
+	iter := itertools.AsIter([]string{"apple", "avocado", "banana", "apricot"})
+	dedup := itertools.DedupBy[string](iter, func(v string) byte { return v[0] })
+
+	assert.Equal(t, []string{"apple", "banana", "apricot"}, dedup.Collect())
+}
+
+func TestUnique(t *testing.T) {
+	iter := itertools.AsIter([]int{1, 2, 1, 3, 2})
+
+	assert.Equal(t, []int{1, 2, 3}, itertools.Unique[int](iter).Collect())
+}
+
+func TestUniqueBy(t *testing.T) {
+	iter := itertools.AsIter([]string{"a", "B", "A", "b"})
+
+	assert.Equal(t, []string{"a", "B"}, itertools.UniqueBy[string](iter, strings.ToLower).Collect())
+}
+
+func TestUniqueCycle(t *testing.T) {
+	cycle := itertools.Cycle[int](itertools.AsIter([]int{1, 2, 3}))
+
+	assert.Equal(t, []int{1, 2, 3}, itertools.Unique[int](cycle).Take(3).Collect())
+}
@@ -0,0 +1,186 @@
+package encoding
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+
+	"github.com/skylissh/std-go/itertools/iters"
+)
+
+// Returns an iterator over the records of a CSV stream, including the header
+// if there is one.
+//
+// # Example
+//
+//	records := encoding.CSVRecords(strings.NewReader("a,b\n1,2\n"))
+//
+//	assert.Equal(t, [][]string{{"a", "b"}, {"1", "2"}}, records.Collect())
+func CSVRecords(r io.Reader) *Decoder[[]string] {
+	reader := csv.NewReader(r)
+
+	return newDecoder(reader.Read)
+}
+
+// Returns an iterator over the records of a CSV stream, decoded into structs
+// of type T.
+//
+// The first record is the header, and each column is stored in the exported
+// field with the same name, or with the same `csv` tag. Columns without a
+// field are ignored, and fields tagged with `csv:"-"` are skipped. The fields
+// can be strings, booleans, integers or floats.
+//
+// # Example
+//
+//	type Person struct {
+//		Name string `csv:"name"`
+//		Age  int    `csv:"age"`
+//	}
+//
+//	people := encoding.CSVStructs[Person](strings.NewReader("name,age\nAlice,30\n"))
+//
+//	assert.Equal(t, []Person{{"Alice", 30}}, people.Collect())
+func CSVStructs[T any](r io.Reader) *Decoder[T] {
+	reader := csv.NewReader(r)
+	var columns []int
+
+	return newDecoder(func() (T, error) {
+		var value T
+
+		if columns == nil {
+			header, err := reader.Read()
+			if err != nil {
+				return value, err
+			}
+
+			columns, err = csvColumns(reflect.TypeOf(value), header)
+			if err != nil {
+				return value, err
+			}
+		}
+
+		record, err := reader.Read()
+		if err != nil {
+			return value, err
+		}
+
+		fields := reflect.ValueOf(&value).Elem()
+		for i, field := range columns {
+			if field < 0 || i >= len(record) {
+				continue
+			}
+
+			if err := setField(fields.Field(field), record[i]); err != nil {
+				line, _ := reader.FieldPos(i)
+				return value, fmt.Errorf("line %d, column %d: %w", line, i+1, err)
+			}
+		}
+
+		return value, nil
+	})
+}
+
+// Writes the records of the iterator to a CSV stream.
+//
+// Returns the first error writing the records. If the iterator is
+// iters.Fallible, its error is returned once it is exhausted.
+//
+// # Example
+//
+//	records := itertools.AsIter([][]string{{"a", "b"}, {"1", "2"}})
+//
+//	err := encoding.WriteCSV(os.Stdout, records)
+func WriteCSV(w io.Writer, iter iters.Iterable[[]string]) error {
+	writer := csv.NewWriter(w)
+
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		if err := writer.Write(*v); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+
+	return iterErr(iter)
+}
+
+// Returns the index of the field of the struct type for each column of the
+// header, or -1 if the column has no field.
+func csvColumns(t reflect.Type, header []string) ([]int, error) {
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot decode CSV records into %v, it must be a struct", t)
+	}
+
+	names := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("csv"); ok {
+			name = tag
+		}
+
+		if name != "-" {
+			names[name] = i
+		}
+	}
+
+	columns := make([]int, len(header))
+	for i, column := range header {
+		columns[i] = -1
+
+		if field, ok := names[column]; ok {
+			columns[i] = field
+		}
+	}
+
+	return columns, nil
+}
+
+// Parses the string and stores it in the field, according to its kind.
+func setField(field reflect.Value, s string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(s)
+	case reflect.Bool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+
+		field.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(s, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetFloat(v)
+	default:
+		return fmt.Errorf("unsupported field type %v", field.Type())
+	}
+
+	return nil
+}
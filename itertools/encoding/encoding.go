@@ -0,0 +1,85 @@
+// This package provides iterators that decode CSV and NDJSON streams lazily,
+// and sinks that encode the values of an iterator, so big files can be
+// filtered and mapped in constant memory.
+//
+// The decoders implement iters.Fallible, so the read and decode errors are
+// reported by their Err method once they stop.
+//
+// # Example
+//
+// The following example copies the adult people of a CSV file to a NDJSON
+// file.
+//
+//	type Person struct {
+//		Name string `csv:"name" json:"name"`
+//		Age  int    `csv:"age" json:"age"`
+//	}
+//
+//	people := encoding.CSVStructs[Person](input)
+//	adults := people.Filter(func(p Person) bool { return p.Age >= 18 })
+//
+//	if err := encoding.WriteNDJSON[Person](output, adults); err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	if err := people.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+package encoding
+
+import (
+	"errors"
+	"io"
+
+	"github.com/skylissh/std-go/itertools/iters"
+)
+
+// Decoder is an iterator over the values decoded from an io.Reader.
+//
+// The reader can't be decoded again, so the decoder is not cloneable, and
+// cloning an adapter that wraps it panics.
+//
+// This struct is not intended to be used directly, is created by the
+// CSVRecords, CSVStructs and NDJSON functions.
+type Decoder[T any] struct {
+	err error
+
+	*iters.FromFunc[T]
+}
+
+// Returns the first error that happened while reading or decoding, or nil if
+// the reader was decoded until io.EOF.
+func (d *Decoder[T]) Err() error {
+	return d.err
+}
+
+// Returns a new decoder that yields the values returned by decode, until it
+// returns an error. io.EOF stops the decoder without error.
+func newDecoder[T any](decode func() (T, error)) *Decoder[T] {
+	d := &Decoder[T]{}
+
+	d.FromFunc = iters.NewFromFunc(func() (T, bool) {
+		value, err := decode()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				d.err = err
+			}
+
+			return value, false
+		}
+
+		return value, true
+	})
+
+	return d
+}
+
+// Returns the error of the iterator if it implements iters.Fallible, so the
+// sinks can report the errors of the decoders they consume.
+func iterErr[T any](iter iters.Iterable[T]) error {
+	if fallible, ok := iter.(iters.Fallible[T]); ok {
+		return fallible.Err()
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package encoding_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/skylissh/std-go/itertools/encoding"
+	"github.com/stretchr/testify/assert"
+)
+
+type person struct {
+	Name    string  `csv:"name" json:"name"`
+	Age     int     `csv:"age" json:"age"`
+	Score   float64 `csv:"score" json:"-"`
+	Active  bool    `json:"-"`
+	Ignored string  `csv:"-" json:"-"`
+}
+
+func TestCSVRecords(t *testing.T) {
+	records := encoding.CSVRecords(strings.NewReader("a,b\n1,2\n"))
+
+	assert.Equal(t, [][]string{{"a", "b"}, {"1", "2"}}, records.Collect())
+	assert.NoError(t, records.Err())
+}
+
+func TestCSVRecordsError(t *testing.T) {
+	records := encoding.CSVRecords(strings.NewReader("a,b\n1,2,3\n"))
+
+	assert.Equal(t, [][]string{{"a", "b"}}, records.Collect())
+	assert.Error(t, records.Err())
+}
+
+func TestCSVStructs(t *testing.T) {
+	input := "name,age,extra,score,Active,Ignored\nAlice,30,x,1.5,true,no\nBob,17,y,2,false,no\n"
+	people := encoding.CSVStructs[person](strings.NewReader(input))
+	expect := []person{{"Alice", 30, 1.5, true, ""}, {"Bob", 17, 2, false, ""}}
+
+	assert.Equal(t, expect, people.Collect())
+	assert.NoError(t, people.Err())
+}
+
+func TestCSVStructsError(t *testing.T) {
+	people := encoding.CSVStructs[person](strings.NewReader("name,age\nAlice,30\nBob,old\n"))
+
+	assert.Len(t, people.Collect(), 1)
+	assert.ErrorContains(t, people.Err(), "line 3, column 2")
+}
+
+func TestCSVStructsNotStruct(t *testing.T) {
+	values := encoding.CSVStructs[int](strings.NewReader("a\n1\n"))
+
+	assert.Empty(t, values.Collect())
+	assert.Error(t, values.Err())
+}
+
+func TestWriteCSV(t *testing.T) {
+	var out bytes.Buffer
+	records := itertools.AsIter([][]string{{"a", "b"}, {"1", "2,3"}})
+
+	assert.NoError(t, encoding.WriteCSV(&out, records))
+	assert.Equal(t, "a,b\n1,\"2,3\"\n", out.String())
+}
+
+func TestNDJSON(t *testing.T) {
+	people := encoding.NDJSON[person](strings.NewReader("{\"name\":\"Alice\",\"age\":30}\n{\"name\":\"Bob\",\"age\":17}\n"))
+	expect := []person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 17}}
+
+	assert.Equal(t, expect, people.Collect())
+	assert.NoError(t, people.Err())
+}
+
+func TestNDJSONError(t *testing.T) {
+	people := encoding.NDJSON[person](strings.NewReader("{\"name\":\"Alice\"}\n{oops}\n"))
+
+	assert.Len(t, people.Collect(), 1)
+	assert.Error(t, people.Err())
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	var out bytes.Buffer
+	input := "name,age\nAlice,30\nBob,17\n"
+	adults := encoding.CSVStructs[person](strings.NewReader(input)).Filter(func(p person) bool {
+		return p.Age >= 18
+	})
+
+	assert.NoError(t, encoding.WriteNDJSON[person](&out, adults))
+	assert.Equal(t, "{\"name\":\"Alice\",\"age\":30}\n", out.String())
+}
+
+func TestWriteNDJSONFallible(t *testing.T) {
+	var out bytes.Buffer
+	people := encoding.NDJSON[person](strings.NewReader("{\"name\":\"Alice\"}\n{oops}\n"))
+
+	assert.Error(t, encoding.WriteNDJSON[person](&out, people))
+	assert.Equal(t, "{\"name\":\"Alice\",\"age\":0}\n", out.String())
+}
+
+func TestDecodersNotCloneable(t *testing.T) {
+	records := encoding.CSVRecords(strings.NewReader("a,b\n"))
+	people := encoding.NDJSON[person](strings.NewReader(`{"name":"Ann"}`))
+
+	assert.PanicsWithValue(t, "The iterator is not cloneable", func() {
+		itertools.Cycle[[]string](records)
+	})
+	assert.PanicsWithValue(t, "The iterator is not cloneable", func() {
+		people.Filter(func(person) bool { return true }).Clone()
+	})
+}
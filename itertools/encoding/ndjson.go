@@ -0,0 +1,53 @@
+package encoding
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/skylissh/std-go/itertools/iters"
+)
+
+// Returns an iterator over the values of a newline delimited JSON stream,
+// decoded into values of type T.
+//
+// # Example
+//
+//	type Event struct {
+//		Type string `json:"type"`
+//	}
+//
+//	events := encoding.NDJSON[Event](strings.NewReader("{\"type\":\"a\"}\n{\"type\":\"b\"}\n"))
+//
+//	assert.Equal(t, []Event{{"a"}, {"b"}}, events.Collect())
+func NDJSON[T any](r io.Reader) *Decoder[T] {
+	decoder := json.NewDecoder(r)
+
+	return newDecoder(func() (T, error) {
+		var value T
+		err := decoder.Decode(&value)
+		return value, err
+	})
+}
+
+// Writes the values of the iterator to a newline delimited JSON stream, one
+// value per line.
+//
+// Returns the first error encoding or writing the values. If the iterator is
+// iters.Fallible, its error is returned once it is exhausted.
+//
+// # Example
+//
+//	events := itertools.AsIter([]Event{{"a"}, {"b"}})
+//
+//	err := encoding.WriteNDJSON[Event](os.Stdout, events)
+func WriteNDJSON[T any](w io.Writer, iter iters.Iterable[T]) error {
+	encoder := json.NewEncoder(w)
+
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		if err := encoder.Encode(*v); err != nil {
+			return err
+		}
+	}
+
+	return iterErr(iter)
+}
@@ -0,0 +1,33 @@
+package itertools
+
+import "github.com/skylissh/std-go/itertools/iters"
+
+// Returns an iterator that yields pairs with the index, starting from 0, and
+// the value of the original iterator.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"a", "b", "c"})
+//	odds := itertools.Enumerate[string](iter).Filter(func(p iters.Pair[int, string]) bool {
+//		return p.First%2 == 1
+//	})
+//
+//	assert.Equal(t, iters.NewPair(1, "b"), *odds.Next())
+//	assert.Nil(t, odds.Next())
+func Enumerate[T any](iter iters.Iterable[T]) *iters.Enumerate[T] {
+	return iters.NewEnumerate(iter, 0)
+}
+
+// Returns an iterator that yields pairs with the index, starting from start,
+// and the value of the original iterator.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"a", "b"})
+//	enumerate := itertools.EnumerateFrom[string](iter, 1)
+//
+//	assert.Equal(t, iters.NewPair(1, "a"), *enumerate.Next())
+//	assert.Equal(t, iters.NewPair(2, "b"), *enumerate.Next())
+func EnumerateFrom[T any](iter iters.Iterable[T], start int) *iters.Enumerate[T] {
+	return iters.NewEnumerate(iter, start)
+}
@@ -0,0 +1,42 @@
+package itertools_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/skylissh/std-go/itertools/iters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumerate(t *testing.T) {
+	iter := itertools.AsIter([]string{"a", "b", "c"})
+	expect := []iters.Pair[int, string]{iters.NewPair(0, "a"), iters.NewPair(1, "b"), iters.NewPair(2, "c")}
+
+	assert.Equal(t, expect, itertools.Enumerate[string](iter).Collect())
+}
+
+func TestEnumerateFrom(t *testing.T) {
+	iter := itertools.AsIter([]string{"a", "b"})
+	expect := []iters.Pair[int, string]{iters.NewPair(10, "a"), iters.NewPair(11, "b")}
+
+	assert.Equal(t, expect, itertools.EnumerateFrom[string](iter, 10).Collect())
+}
+
+func TestEnumerateMap(t *testing.T) {
+	iter := itertools.AsIter([]string{"a", "b", "c"})
+	odds := itertools.Enumerate[string](iter).Filter(func(p iters.Pair[int, string]) bool {
+		return p.First%2 == 1
+	})
+	values := itertools.Map[iters.Pair[int, string]](odds, func(p iters.Pair[int, string]) string {
+		return p.Second
+	})
+
+	assert.Equal(t, []string{"b"}, values.Collect())
+}
+
+func TestEnumerateClone(t *testing.T) {
+	enumerate := itertools.EnumerateFrom[int](itertools.AsIter([]int{5, 6}), 1)
+	enumerate.Next()
+
+	assert.Equal(t, iters.NewPair(1, 5), *enumerate.Clone().Next())
+}
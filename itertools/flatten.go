@@ -0,0 +1,48 @@
+package itertools
+
+import "github.com/skylissh/std-go/itertools/iters"
+
+// Returns an iterator that yields the values of each iterator, one after
+// the other.
+//
+// # Example
+//
+//	chain := itertools.Chain[int](
+//		itertools.AsIter([]int{1, 2}),
+//		itertools.AsIter([]int{3}),
+//	)
+//
+//	assert.Equal(t, []int{1, 2, 3}, chain.Collect())
+func Chain[T any](iterables ...iters.Iterable[T]) *iters.Chain[T] {
+	return iters.NewChain(iterables...)
+}
+
+// Returns an iterator that yields the values of each iterator yielded by
+// the original iterator.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]iters.Iterable[int]{
+//		itertools.AsIter([]int{1, 2}),
+//		itertools.AsIter([]int{3}),
+//	})
+//
+//	assert.Equal(t, []int{1, 2, 3}, itertools.Flatten[int](iter).Collect())
+func Flatten[T any](iter iters.Iterable[iters.Iterable[T]]) *iters.Flatten[T] {
+	return iters.NewFlatten(iter)
+}
+
+// Returns an iterator that maps each value to an iterator using the function
+// f, and yields the values of the resulting iterators.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2})
+//	m := itertools.FlatMap[int, int](iter, func(v int) iters.Iterable[int] {
+//		return itertools.AsIter([]int{v, v * 10})
+//	})
+//
+//	assert.Equal(t, []int{1, 10, 2, 20}, m.Collect())
+func FlatMap[T, E any](iter iters.Iterable[T], f func(value T) iters.Iterable[E]) *iters.FlatMap[T, E] {
+	return iters.NewFlatMap(iter, f)
+}
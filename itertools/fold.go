@@ -0,0 +1,27 @@
+package itertools
+
+import "github.com/skylissh/std-go/itertools/iters"
+
+// Folds the values of the iterator into an accumulator, starting from init and
+// applying the function f to each value.
+//
+// Unlike Reduce, the accumulator can have a different type than the values,
+// and an empty iterator just returns init.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"a", "bb", "ccc"})
+//	total := itertools.Fold[string](iter, 0, func(acc int, value string) int {
+//		return acc + len(value)
+//	})
+//
+//	assert.Equal(t, 6, total)
+func Fold[T, A any](iter iters.Iterable[T], init A, f func(acc A, value T) A) A {
+	acc := init
+
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		acc = f(acc, *v)
+	}
+
+	return acc
+}
@@ -0,0 +1,33 @@
+package itertools_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFold(t *testing.T) {
+	iter := itertools.AsIter([]string{"a", "bb", "ccc"})
+	total := itertools.Fold[string](iter, 0, func(acc int, value string) int {
+		return acc + len(value)
+	})
+
+	assert.Equal(t, 6, total)
+}
+
+func TestFoldEmpty(t *testing.T) {
+	iter := itertools.AsIter([]int{})
+
+	assert.Equal(t, "init", itertools.Fold[int](iter, "init", func(acc string, value int) string {
+		return acc + "!"
+	}))
+}
+
+func TestFoldSingleton(t *testing.T) {
+	iter := itertools.AsIter([]int{3})
+
+	assert.Equal(t, 13, itertools.Fold[int](iter, 10, func(acc, value int) int {
+		return acc + value
+	}))
+}
@@ -0,0 +1,77 @@
+package itertools
+
+import "github.com/skylissh/std-go/itertools/iters"
+
+// Returns an iterator that yields numbers from start, up to but not including
+// stop, advancing step each time. It works with any integer or floating-point
+// type.
+//
+// The step can be negative to count down. Panics if step is 0.
+//
+// # Example
+//
+//	assert.Equal(t, []int{0, 3, 6, 9}, itertools.Range(0, 10, 3).Collect())
+//	assert.Equal(t, []int{3, 2, 1}, itertools.Range(3, 0, -1).Collect())
+//	assert.Equal(t, []float64{0, 0.5, 1}, itertools.Range(0, 1.5, 0.5).Collect())
+func Range[T iters.Number](start, stop, step T) *iters.Range[T] {
+	return iters.NewRange(start, stop, step)
+}
+
+// Returns an endless iterator that yields numbers from start, increasing by
+// one each time.
+//
+// # Example
+//
+//	assert.Equal(t, []int{5, 6, 7}, itertools.Count(5).Take(3).Collect())
+func Count[T iters.Number](start T) *iters.Count[T] {
+	return iters.NewCount(start)
+}
+
+// Returns an endless iterator that yields the same value over and over.
+//
+// # Example
+//
+//	assert.Equal(t, []string{"a", "a", "a"}, itertools.Repeat("a").Take(3).Collect())
+func Repeat[T any](value T) *iters.Repeat[T] {
+	return iters.NewRepeat(value, 0, true)
+}
+
+// Returns an iterator that yields the same value n times.
+//
+// # Example
+//
+//	assert.Equal(t, []string{"a", "a"}, itertools.RepeatN("a", 2).Collect())
+func RepeatN[T any](value T, n uint) *iters.Repeat[T] {
+	return iters.NewRepeat(value, n, false)
+}
+
+// Returns an endless iterator that yields seed, f(seed), f(f(seed)) and so on.
+//
+// # Example
+//
+//	powers := itertools.Iterate(1, func(v int) int { return v * 2 })
+//
+//	assert.Equal(t, []int{1, 2, 4, 8}, powers.Take(4).Collect())
+func Iterate[T any](seed T, f func(value T) T) *iters.Iterate[T] {
+	return iters.NewIterate(seed, f)
+}
+
+// Returns an iterator that yields the values returned by the function f,
+// until it returns false.
+//
+// The iterator is not cloneable, so Cycle or the Clone method of the adapters
+// that wrap it panic.
+//
+// # Example
+//
+//	scanner := bufio.NewScanner(os.Stdin)
+//	lines := itertools.FromFunc(func() (string, bool) {
+//		if !scanner.Scan() {
+//			return "", false
+//		}
+//
+//		return scanner.Text(), true
+//	})
+func FromFunc[T any](f func() (T, bool)) *iters.FromFunc[T] {
+	return iters.NewFromFunc(f)
+}
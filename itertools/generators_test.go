@@ -0,0 +1,79 @@
+package itertools_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange(t *testing.T) {
+	assert.Equal(t, []int{0, 3, 6, 9}, itertools.Range(0, 10, 3).Collect())
+	assert.Equal(t, []int{3, 2, 1}, itertools.Range(3, 0, -1).Collect())
+	assert.Equal(t, []float64{0, 0.1, 0.2}, itertools.Range(0, 0.3, 0.1).Collect())
+	assert.Empty(t, itertools.Range(uint(5), 5, 1).Collect())
+	assert.Equal(t, []int8{0, 50, 100}, itertools.Range[int8](0, 120, 50).Collect())
+	assert.Equal(t, []int8{-100, -120}, itertools.Range[int8](-100, -128, -20).Collect())
+	assert.Equal(t, []int8{100, 120}, itertools.Range[int8](100, 127, 20).Collect())
+	assert.Equal(t, []uint8{0, 100, 200}, itertools.Range[uint8](0, 250, 100).Collect())
+	assert.Equal(t, []uint8{254}, itertools.Range[uint8](254, 255, 1).Collect())
+}
+
+func TestRangeZeroStep(t *testing.T) {
+	assert.Panics(t, func() {
+		itertools.Range(0, 10, 0)
+	})
+}
+
+func TestRangeCycle(t *testing.T) {
+	cycle := itertools.Cycle[int](itertools.Range(0, 3, 1))
+
+	assert.Equal(t, []int{0, 1, 2, 0, 1}, cycle.Take(5).Collect())
+}
+
+func TestCount(t *testing.T) {
+	count := itertools.Count(5)
+
+	assert.Equal(t, []int{5, 6, 7}, count.Take(3).Collect())
+	assert.Equal(t, []int{5, 6}, count.Clone().Take(2).Collect())
+}
+
+func TestRepeat(t *testing.T) {
+	assert.Equal(t, []string{"a", "a", "a"}, itertools.Repeat("a").Take(3).Collect())
+}
+
+func TestRepeatN(t *testing.T) {
+	repeat := itertools.RepeatN("a", 2)
+
+	assert.Equal(t, []string{"a", "a"}, repeat.Collect())
+	assert.Equal(t, []string{"a", "a"}, repeat.Clone().Collect())
+}
+
+func TestIterate(t *testing.T) {
+	powers := itertools.Iterate(1, func(v int) int { return v * 2 })
+
+	assert.Equal(t, []int{1, 2, 4, 8}, powers.Take(4).Collect())
+}
+
+func TestFromFunc(t *testing.T) {
+	n := 0
+	from := itertools.FromFunc(func() (int, bool) {
+		n++
+		return n, n <= 3
+	})
+
+	assert.Equal(t, []int{1, 2, 3}, from.Collect())
+	assert.Nil(t, from.Next())
+	assert.Equal(t, 4, n)
+}
+
+func TestFromFuncNotCloneable(t *testing.T) {
+	from := itertools.FromFunc(func() (int, bool) { return 1, true })
+
+	assert.PanicsWithValue(t, "The iterator is not cloneable", func() {
+		itertools.Cycle[int](from)
+	})
+	assert.PanicsWithValue(t, "The iterator is not cloneable", func() {
+		from.Filter(func(int) bool { return true }).Clone()
+	})
+}
@@ -0,0 +1,61 @@
+package itertools
+
+import "github.com/skylissh/std-go/itertools/iters"
+
+// Returns an iterator that yields pairs with a key and the consecutive values
+// of the original iterator that share that key.
+//
+// Like Python's itertools.groupby, only consecutive values are grouped, so
+// the iterator stays lazy and can be used with endless iterators. To group
+// all the values by key use GroupInto.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 3, 2, 4, 5})
+//	groups := itertools.GroupBy[int](iter, func(v int) bool { return v%2 == 0 })
+//
+//	assert.Equal(t, iters.NewPair(false, []int{1, 3}), *groups.Next())
+//	assert.Equal(t, iters.NewPair(true, []int{2, 4}), *groups.Next())
+//	assert.Equal(t, iters.NewPair(false, []int{5}), *groups.Next())
+//	assert.Nil(t, groups.Next())
+func GroupBy[T any, K comparable](iter iters.Iterable[T], key func(value T) K) *iters.GroupBy[T, K] {
+	return iters.NewGroupBy(iter, key)
+}
+
+// Returns an iterator that yields runs of consecutive values of the original
+// iterator. A new run starts every time the predicate returns false for the
+// previous and the next value.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 5, 6, 8})
+//	runs := itertools.ChunkBy[int](iter, func(prev, next int) bool {
+//		return next == prev+1
+//	})
+//
+//	assert.Equal(t, [][]int{{1, 2, 3}, {5, 6}, {8}}, runs.Collect())
+func ChunkBy[T any](iter iters.Iterable[T], predicate func(prev, next T) bool) *iters.ChunkBy[T] {
+	return iters.NewChunkBy(iter, predicate)
+}
+
+// Consumes the iterator and groups all its values by key. The values of each
+// group keep the order of the iterator.
+//
+// This never returns with endless iterators, use GroupBy for them.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4, 5})
+//	groups := itertools.GroupInto[int](iter, func(v int) bool { return v%2 == 0 })
+//
+//	assert.Equal(t, map[bool][]int{false: {1, 3, 5}, true: {2, 4}}, groups)
+func GroupInto[T any, K comparable](iter iters.Iterable[T], key func(value T) K) map[K][]T {
+	groups := make(map[K][]T)
+
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		k := key(*v)
+		groups[k] = append(groups[k], *v)
+	}
+
+	return groups
+}
@@ -0,0 +1,49 @@
+package itertools_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/skylissh/std-go/itertools/iters"
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+func TestGroupBy(t *testing.T) {
+	iter := itertools.AsIter([]int{1, 3, 2, 4, 5})
+	expect := []iters.Pair[bool, []int]{
+		iters.NewPair(false, []int{1, 3}),
+		iters.NewPair(true, []int{2, 4}),
+		iters.NewPair(false, []int{5}),
+	}
+
+	assert.Equal(t, expect, itertools.GroupBy[int](iter, isEven).Collect())
+}
+
+func TestGroupByCycle(t *testing.T) {
+	cycle := itertools.Cycle[int](itertools.AsIter([]int{1, 1, 2}))
+	groups := itertools.GroupBy[int](cycle, isEven)
+
+	assert.Equal(t, iters.NewPair(false, []int{1, 1}), *groups.Next())
+	assert.Equal(t, iters.NewPair(true, []int{2}), *groups.Next())
+	assert.Equal(t, iters.NewPair(false, []int{1, 1}), *groups.Next())
+}
+
+func TestChunkBy(t *testing.T) {
+	iter := itertools.AsIter([]int{1, 2, 3, 5, 6, 8})
+	runs := itertools.ChunkBy[int](iter, func(prev, next int) bool {
+		return next == prev+1
+	})
+
+	assert.Equal(t, [][]int{{1, 2, 3}, {5, 6}, {8}}, runs.Collect())
+}
+
+func TestGroupInto(t *testing.T) {
+	iter := itertools.AsIter([]int{1, 2, 3, 4, 5})
+	expect := map[bool][]int{false: {1, 3, 5}, true: {2, 4}}
+
+	assert.Equal(t, expect, itertools.GroupInto[int](iter, isEven))
+}
@@ -0,0 +1,207 @@
+package iters
+
+// NewChain returns a new iterator that yields the values of each iterator,
+// one after the other.
+//
+// This function is only intended to be used by the Chain method.
+func NewChain[T any](iters ...Iterable[T]) *Chain[T] {
+	chain := &Chain[T]{iters, 0, Iterator[T]{}}
+	chain.Iterator.iterable = chain
+	return chain
+}
+
+// Chain is an iterator that yields the values of each iterator, one after
+// the other.
+//
+// This struct is not intended to be used directly, is created by the Chain
+// method.
+type Chain[T any] struct {
+	iters   []Iterable[T]
+	current int
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value.
+//
+// When the current iterator is exhausted, it moves to the next one.
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2})
+//	chain := iter.Chain(itertools.AsIter([]int{3}))
+//
+//	assert.Equal(t, 1, *chain.Next())
+//	assert.Equal(t, 2, *chain.Next())
+//	assert.Equal(t, 3, *chain.Next())
+//	assert.Nil(t, chain.Next())
+func (chain *Chain[T]) Next() *T {
+	for chain.current < len(chain.iters) {
+		if v := chain.iters[chain.current].Next(); v != nil {
+			return v
+		}
+
+		chain.current++
+	}
+
+	return nil
+}
+
+// Returns a new iterator with the same values as the original, starting
+// again from the first chained iterator.
+//
+// All the chained iterators are also cloned, so it panics if any of them is
+// not cloneable.
+func (chain *Chain[T]) Clone() *Chain[T] {
+	iters := make([]Iterable[T], len(chain.iters))
+	for i, iter := range chain.iters {
+		iters[i] = clone(iter)
+	}
+
+	return NewChain(iters...)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (chain *Chain[T]) cloneIter() Iterable[T] {
+	return chain.Clone()
+}
+
+// NewFlatten returns a new iterator that yields the values of each iterator
+// yielded by another iterator.
+//
+// This function is only intended to be used by the top level Flatten method.
+func NewFlatten[T any](iter Iterable[Iterable[T]]) *Flatten[T] {
+	flatten := &Flatten[T]{iter, nil, false, Iterator[T]{}}
+	flatten.Iterator.iterable = flatten
+	return flatten
+}
+
+// Flatten is an iterator that removes one level of nesting from an iterator
+// of iterators.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Flatten method.
+type Flatten[T any] struct {
+	iter    Iterable[Iterable[T]]
+	current Iterable[T]
+	// The inner iterators are cloned before being consumed, so the clones
+	// of a Flatten don't share them with the original.
+	cloned bool
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value of the current inner
+// iterator.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]iters.Iterable[int]{
+//		itertools.AsIter([]int{1, 2}),
+//		itertools.AsIter([]int{3}),
+//	})
+//	flatten := itertools.Flatten[int](iter)
+//
+//	assert.Equal(t, []int{1, 2, 3}, flatten.Collect())
+func (flatten *Flatten[T]) Next() *T {
+	for {
+		if flatten.current != nil {
+			if v := flatten.current.Next(); v != nil {
+				return v
+			}
+		}
+
+		next := flatten.iter.Next()
+		if next == nil {
+			return nil
+		}
+
+		flatten.current = *next
+		if flatten.cloned {
+			flatten.current = clone(flatten.current)
+		}
+	}
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The outer iterator is cloned, and so are the inner iterators once they are
+// reached, so it panics if any of them is not cloneable.
+func (flatten *Flatten[T]) Clone() *Flatten[T] {
+	clonned := NewFlatten(clone(flatten.iter))
+	clonned.cloned = true
+	return clonned
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (flatten *Flatten[T]) cloneIter() Iterable[T] {
+	return flatten.Clone()
+}
+
+// NewFlatMap returns a new iterator that maps each value of another iterator
+// to an iterator, and yields the values of the resulting iterators.
+//
+// This function is only intended to be used by the top level FlatMap method.
+func NewFlatMap[T, E any](iter Iterable[T], f func(T) Iterable[E]) *FlatMap[T, E] {
+	m := &FlatMap[T, E]{iter, f, nil, Iterator[E]{}}
+	m.Iterator.iterable = m
+	return m
+}
+
+// FlatMap is an iterator that maps each value of another iterator to an
+// iterator, and yields the values of the resulting iterators.
+//
+// This struct is not intended to be used directly, is created by the top
+// level FlatMap method.
+type FlatMap[T, E any] struct {
+	iter    Iterable[T]
+	f       func(T) Iterable[E]
+	current Iterable[E]
+
+	Iterator[E]
+}
+
+// Advances the iterator and returns the next value of the current mapped
+// iterator.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2})
+//	m := itertools.FlatMap[int, int](iter, func(v int) iters.Iterable[int] {
+//		return itertools.AsIter([]int{v, v * 10})
+//	})
+//
+//	assert.Equal(t, []int{1, 10, 2, 20}, m.Collect())
+func (m *FlatMap[T, E]) Next() *E {
+	for {
+		if m.current != nil {
+			if v := m.current.Next(); v != nil {
+				return v
+			}
+		}
+
+		next := m.iter.Next()
+		if next == nil {
+			return nil
+		}
+
+		m.current = m.f(*next)
+	}
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (m *FlatMap[T, E]) Clone() *FlatMap[T, E] {
+	return NewFlatMap(clone(m.iter), m.f)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (m *FlatMap[T, E]) cloneIter() Iterable[E] {
+	return m.Clone()
+}
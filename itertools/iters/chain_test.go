@@ -0,0 +1,63 @@
+package iters_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/itertools/iters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChain(t *testing.T) {
+	iter := iters.NewIter(&[]int{1, 2})
+	expect := []int{1, 2, 3, 4, 5}
+
+	assert.Equal(t, expect, iter.Chain(iters.NewIter(&[]int{3, 4}), iters.NewIter(&[]int{5})).Collect())
+}
+
+func TestChainEmpty(t *testing.T) {
+	assert.Empty(t, iters.NewChain[int]().Collect())
+}
+
+func TestChainClone(t *testing.T) {
+	chain := iters.NewChain[int](iters.NewIter(&[]int{1, 2}), iters.NewIter(&[]int{3}))
+	clonned := chain.Clone()
+
+	assert.Equal(t, []int{1, 2, 3}, chain.Collect())
+	assert.Equal(t, []int{1, 2, 3}, clonned.Collect())
+}
+
+func TestChainCloneAdvanced(t *testing.T) {
+	chain := iters.NewChain[int](iters.NewIter(&[]int{1, 2}), iters.NewIter(&[]int{3}))
+	chain.Take(3).Collect()
+
+	assert.Equal(t, []int{1, 2, 3}, chain.Clone().Collect())
+}
+
+func TestFlatten(t *testing.T) {
+	iter := iters.NewIter(&[]iters.Iterable[int]{
+		iters.NewIter(&[]int{1, 2}),
+		iters.NewIter(&[]int{}),
+		iters.NewIter(&[]int{3}),
+	})
+	flatten := iters.NewFlatten[int](iter)
+	clonned := flatten.Clone()
+
+	assert.Equal(t, []int{1, 2, 3}, flatten.Collect())
+	assert.Equal(t, []int{1, 2, 3}, clonned.Collect())
+}
+
+func TestFlatMap(t *testing.T) {
+	iter := iters.NewIter(&[]int{1, 2})
+	m := iters.NewFlatMap[int, int](iter, func(v int) iters.Iterable[int] {
+		return iters.NewIter(&[]int{v, v * 10})
+	})
+
+	assert.Equal(t, []int{1, 10, 2, 20}, m.Collect())
+}
+
+func TestCycleChain(t *testing.T) {
+	chain := iters.NewChain[int](iters.NewIter(&[]int{1}), iters.NewIter(&[]int{2}))
+	cycle := iters.NewCycle[int](chain)
+
+	assert.Equal(t, []int{1, 2, 1, 2, 1}, cycle.Take(5).Collect())
+}
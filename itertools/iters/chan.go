@@ -0,0 +1,43 @@
+package iters
+
+// NewChan returns a new iterator that receives the values of a channel.
+//
+// This function is only intended to be used by the top level FromChan method.
+func NewChan[T any](ch <-chan T) *Chan[T] {
+	c := &Chan[T]{ch, Iterator[T]{}}
+	c.Iterator.iterable = c
+	return c
+}
+
+// Chan is an iterator that receives the values of a channel, until it is
+// closed.
+//
+// This struct is not intended to be used directly, is created by the top
+// level FromChan method.
+type Chan[T any] struct {
+	ch <-chan T
+
+	Iterator[T]
+}
+
+// Blocks until a value is received from the channel, and returns it.
+//
+// If the channel is closed, nil is returned.
+//
+// # Example
+//
+//	ch := make(chan int, 2)
+//	ch <- 1
+//	ch <- 2
+//	close(ch)
+//
+//	assert.Equal(t, []int{1, 2}, itertools.FromChan(ch).Collect())
+func (c *Chan[T]) Next() *T {
+	value, ok := <-c.ch
+
+	if !ok {
+		return nil
+	}
+
+	return &value
+}
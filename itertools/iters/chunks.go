@@ -0,0 +1,262 @@
+package iters
+
+// NewChunks returns a new iterator that yields the values of another iterator
+// in non-overlapping slices of size n.
+//
+// If exact is true, the last slice is dropped when it has fewer than n values.
+// The size must be greater than 0, otherwise it panics.
+//
+// This function is only intended to be used by the top level Chunks and
+// ChunksExact methods.
+func NewChunks[T any](iter Iterable[T], n uint, exact bool) *Chunks[T] {
+	chunks := &Chunks[T]{newChunker(iter, n, exact), Iterator[[]T]{}}
+	chunks.Iterator.iterable = chunks
+	return chunks
+}
+
+// Chunks is an iterator that yields the values of another iterator in
+// non-overlapping slices.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Chunks and ChunksExact methods.
+type Chunks[T any] struct {
+	chunker *chunker[T]
+
+	Iterator[[]T]
+}
+
+// Advances the iterator up to n times, and returns a new slice with the
+// values.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4, 5})
+//	chunks := itertools.Chunks[int](iter, 2)
+//
+//	assert.Equal(t, []int{1, 2}, *chunks.Next())
+//	assert.Equal(t, []int{3, 4}, *chunks.Next())
+//	assert.Equal(t, []int{5}, *chunks.Next())
+//	assert.Nil(t, chunks.Next())
+func (chunks *Chunks[T]) Next() *[]T {
+	return chunks.chunker.Next()
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (chunks *Chunks[T]) Clone() *Chunks[T] {
+	return NewChunks(clone(chunks.chunker.iter), chunks.chunker.n, chunks.chunker.exact)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (chunks *Chunks[T]) cloneIter() Iterable[[]T] {
+	return chunks.Clone()
+}
+
+// Returns a new chunker, panicking if the size is 0.
+func newChunker[T any](iter Iterable[T], n uint, exact bool) *chunker[T] {
+	if n == 0 {
+		panic("The chunk size must be greater than 0")
+	}
+
+	return &chunker[T]{iter, n, exact}
+}
+
+// chunker yields the chunks of another iterator.
+//
+// It doesn't embed Iterator, so the methods of Iterator[T] can return it
+// without instantiating Iterator[[]T], which would be an instantiation cycle.
+type chunker[T any] struct {
+	iter  Iterable[T]
+	n     uint
+	exact bool
+}
+
+// Advances the iterator up to n times, and returns a new slice with the
+// values, or nil if there are no more.
+func (c *chunker[T]) Next() *[]T {
+	chunk := make([]T, 0, c.n)
+
+	for uint(len(chunk)) < c.n {
+		v := c.iter.Next()
+		if v == nil {
+			break
+		}
+
+		chunk = append(chunk, *v)
+	}
+
+	if len(chunk) == 0 || (c.exact && uint(len(chunk)) < c.n) {
+		return nil
+	}
+
+	return &chunk
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (c *chunker[T]) cloneIter() Iterable[[]T] {
+	return newChunker(clone(c.iter), c.n, c.exact)
+}
+
+// NewWindows returns a new iterator that yields overlapping slices of size n
+// with the values of another iterator.
+//
+// The size must be greater than 0, otherwise it panics.
+//
+// This function is only intended to be used by the top level Windows method.
+func NewWindows[T any](iter Iterable[T], n uint) *Windows[T] {
+	windows := &Windows[T]{&windower[T]{NewWindowsView(iter, n)}, Iterator[[]T]{}}
+	windows.Iterator.iterable = windows
+	return windows
+}
+
+// Windows is an iterator that yields overlapping slices of another iterator.
+//
+// Each window is a new slice, so they can be kept after advancing the
+// iterator. Use WindowsView to avoid the allocation of each window.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Windows method.
+type Windows[T any] struct {
+	windower *windower[T]
+
+	Iterator[[]T]
+}
+
+// Advances the iterator and returns a new slice with the next window of n
+// values.
+//
+// If there are less than n values remaining, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4})
+//	windows := itertools.Windows[int](iter, 3)
+//
+//	assert.Equal(t, []int{1, 2, 3}, *windows.Next())
+//	assert.Equal(t, []int{2, 3, 4}, *windows.Next())
+//	assert.Nil(t, windows.Next())
+func (windows *Windows[T]) Next() *[]T {
+	return windows.windower.Next()
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (windows *Windows[T]) Clone() *Windows[T] {
+	view := windows.windower.view
+	return NewWindows(clone(view.iter), view.n)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (windows *Windows[T]) cloneIter() Iterable[[]T] {
+	return windows.Clone()
+}
+
+// windower yields a copy of each window of a WindowsView.
+//
+// Like chunker, it doesn't embed Iterator so the methods of Iterator[T] can
+// return it.
+type windower[T any] struct {
+	view *WindowsView[T]
+}
+
+// Advances the iterator and returns a copy of the next window, or nil if
+// there are less than n values remaining.
+func (w *windower[T]) Next() *[]T {
+	view := w.view.Next()
+	if view == nil {
+		return nil
+	}
+
+	window := make([]T, len(*view))
+	copy(window, *view)
+	return &window
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (w *windower[T]) cloneIter() Iterable[[]T] {
+	return &windower[T]{w.view.Clone()}
+}
+
+// NewWindowsView returns a new iterator that yields overlapping views of
+// size n over the values of another iterator.
+//
+// The size must be greater than 0, otherwise it panics.
+//
+// This function is only intended to be used by the top level WindowsView
+// method.
+func NewWindowsView[T any](iter Iterable[T], n uint) *WindowsView[T] {
+	if n == 0 {
+		panic("The window size must be greater than 0")
+	}
+
+	return &WindowsView[T]{iter, n, make([]T, 2*n), 0, nil}
+}
+
+// WindowsView is an iterator that yields overlapping slices of another
+// iterator, reusing the same buffer for every window.
+//
+// The values are stored twice in a ring buffer of size 2n, so every window
+// is a contiguous view of the buffer and no allocation is done per step.
+// As the windows are overwritten when the iterator advances, it doesn't
+// embed Iterator, whose methods like Collect would keep them. It can still
+// be wrapped by adapters that use each window before the next one, like Map.
+//
+// This struct is not intended to be used directly, is created by the top
+// level WindowsView method.
+type WindowsView[T any] struct {
+	iter   Iterable[T]
+	n      uint
+	buf    []T
+	count  uint
+	window []T
+}
+
+// Advances the iterator and returns the next window of n values.
+//
+// The returned slice is reused by the following calls to Next, so you need
+// to copy it if you want to keep the values. If there are less than n values
+// remaining, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4})
+//	windows := itertools.WindowsView[int](iter, 3)
+//
+//	assert.Equal(t, []int{1, 2, 3}, *windows.Next())
+//	assert.Equal(t, []int{2, 3, 4}, *windows.Next())
+//	assert.Nil(t, windows.Next())
+func (windows *WindowsView[T]) Next() *[]T {
+	for {
+		v := windows.iter.Next()
+		if v == nil {
+			return nil
+		}
+
+		pos := windows.count % windows.n
+		windows.buf[pos] = *v
+		windows.buf[pos+windows.n] = *v
+		windows.count++
+
+		if windows.count >= windows.n {
+			start := windows.count % windows.n
+			windows.window = windows.buf[start : start+windows.n : start+windows.n]
+			return &windows.window
+		}
+	}
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (windows *WindowsView[T]) Clone() *WindowsView[T] {
+	return NewWindowsView(clone(windows.iter), windows.n)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (windows *WindowsView[T]) cloneIter() Iterable[[]T] {
+	return windows.Clone()
+}
@@ -0,0 +1,98 @@
+package iters_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/itertools/iters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunks(t *testing.T) {
+	iter := _iter.Clone()
+	expect := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}, {10}}
+
+	assert.Equal(t, expect, iters.NewChunks[int](iter, 3, false).Collect())
+}
+
+func TestChunksExact(t *testing.T) {
+	iter := _iter.Clone()
+	expect := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+
+	assert.Equal(t, expect, iters.NewChunks[int](iter, 3, true).Collect())
+}
+
+func TestChunksZero(t *testing.T) {
+	assert.Panics(t, func() {
+		iters.NewChunks[int](_iter.Clone(), 0, false)
+	})
+}
+
+func TestWindows(t *testing.T) {
+	iter := iters.NewIter(&[]int{1, 2, 3, 4, 5})
+	expect := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+
+	assert.Equal(t, expect, iters.NewWindows[int](iter, 3).Collect())
+}
+
+func TestWindowsTooShort(t *testing.T) {
+	iter := iters.NewIter(&[]int{1, 2})
+
+	assert.Nil(t, iters.NewWindows[int](iter, 3).Next())
+}
+
+func TestWindowsZero(t *testing.T) {
+	assert.Panics(t, func() {
+		iters.NewWindows[int](_iter.Clone(), 0)
+	})
+}
+
+func TestWindowsView(t *testing.T) {
+	iter := iters.NewIter(&[]int{1, 2, 3, 4})
+	windows := iters.NewWindowsView[int](iter, 3)
+
+	first := windows.Next()
+	assert.Equal(t, []int{1, 2, 3}, *first)
+
+	second := windows.Next()
+	assert.Equal(t, []int{2, 3, 4}, *second)
+	assert.Same(t, first, second)
+	assert.Nil(t, windows.Next())
+}
+
+func TestWindowsMovingAverage(t *testing.T) {
+	iter := iters.NewIter(&[]float64{1, 2, 3, 4})
+	averages := iters.NewMap[[]float64](iters.NewWindowsView[float64](iter, 2), func(window []float64) float64 {
+		return (window[0] + window[1]) / 2
+	})
+
+	assert.Equal(t, []float64{1.5, 2.5, 3.5}, averages.Collect())
+}
+
+func TestIteratorChunks(t *testing.T) {
+	chunks := _iter.Clone().Chunks(4)
+	sums := iters.NewMap[[]int](chunks, func(chunk []int) int {
+		return len(chunk)
+	})
+
+	clonned := sums.Clone()
+
+	assert.Equal(t, []int{4, 4, 2}, sums.Collect())
+	assert.Equal(t, 4, *clonned.Next())
+}
+
+func TestIteratorChunksExact(t *testing.T) {
+	chunks := _iter.Clone().ChunksExact(4)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, *chunks.Next())
+	assert.Equal(t, []int{5, 6, 7, 8}, *chunks.Next())
+	assert.Nil(t, chunks.Next())
+}
+
+func TestIteratorWindows(t *testing.T) {
+	windows := iters.NewIter(&[]int{1, 2, 3, 4}).Windows(3)
+	first := windows.Next()
+
+	assert.Equal(t, []int{2, 3, 4}, *windows.Next())
+	assert.Equal(t, []int{1, 2, 3}, *first)
+	assert.Nil(t, windows.Next())
+}
@@ -0,0 +1,37 @@
+package iters
+
+// Sink is the interface implemented by anything that can receive the values
+// of an iterator.
+type Sink[T any] interface {
+	// Receives the next value of the iterator.
+	Add(value T)
+}
+
+// Collector is a Sink that builds a result of type R from the values it
+// receives, like a map, a set or a string.
+//
+// A collector keeps the values it received, so the result of a collector
+// used twice includes the values of both iterators. Create a new collector
+// for each collection.
+//
+// You can implement this interface to create your own collector.
+type Collector[T, R any] interface {
+	Sink[T]
+
+	// Returns the result built from the values received so far.
+	Result() R
+}
+
+// Consumes the iterator, and adds each value to the sink. Use the Result
+// method of the collector to get the result, or the top level CollectInto
+// method to get it directly.
+//
+// # Example
+//
+//	set := itertools.ToSet[int]()
+//	itertools.AsIter([]int{1, 2, 1}).Drain(set)
+//
+//	assert.Equal(t, map[int]struct{}{1: {}, 2: {}}, set.Result())
+func (iter *Iterator[T]) Drain(sink Sink[T]) {
+	iter.ForEach(sink.Add)
+}
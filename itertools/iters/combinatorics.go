@@ -0,0 +1,500 @@
+package iters
+
+import (
+	"math"
+	"math/bits"
+)
+
+// NewProduct returns a new iterator that yields the cartesian product of the
+// values of the given iterators.
+//
+// The iterators are consumed and buffered when the product is created.
+//
+// This function is only intended to be used by the top level Product method.
+func NewProduct[T any](iters ...Iterable[T]) *Product[T] {
+	pools := make([][]T, len(iters))
+	for i, iter := range iters {
+		pools[i] = buffer(iter)
+	}
+
+	return newProduct(pools)
+}
+
+func newProduct[T any](pools [][]T) *Product[T] {
+	product := &Product[T]{pools, make([]int, len(pools)), false, false, Iterator[[]T]{}}
+	product.Iterator.iterable = product
+	return product
+}
+
+// Product is an iterator that yields the cartesian product of the values of
+// several iterators, like nested for loops, with the rightmost iterator
+// advancing on every step.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Product method.
+type Product[T any] struct {
+	pools   [][]T
+	indices []int
+	started bool
+	done    bool
+
+	Iterator[[]T]
+}
+
+// Returns a new slice with the next combination of values.
+//
+// If there are no more combinations, nil is returned.
+//
+// # Example
+//
+//	product := itertools.Product[int](itertools.AsIter([]int{1, 2}), itertools.AsIter([]int{3, 4}))
+//
+//	assert.Equal(t, [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}, product.Collect())
+func (product *Product[T]) Next() *[]T {
+	if product.done {
+		return nil
+	}
+
+	if !product.started {
+		product.started = true
+
+		for _, pool := range product.pools {
+			if len(pool) == 0 {
+				product.done = true
+				return nil
+			}
+		}
+
+		return product.current()
+	}
+
+	for i := len(product.indices) - 1; i >= 0; i-- {
+		product.indices[i]++
+
+		if product.indices[i] < len(product.pools[i]) {
+			return product.current()
+		}
+
+		product.indices[i] = 0
+	}
+
+	product.done = true
+	return nil
+}
+
+// Returns the total number of combinations yielded by the iterator.
+//
+// The result saturates at math.MaxUint64 if it does not fit in an uint64.
+func (product *Product[T]) Len() uint64 {
+	count := uint64(1)
+	for _, pool := range product.pools {
+		count = mulSat(count, uint64(len(pool)))
+	}
+
+	return count
+}
+
+// Returns a new iterator with the same values as the original, starting from
+// the first combination.
+func (product *Product[T]) Clone() *Product[T] {
+	return newProduct(product.pools)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (product *Product[T]) cloneIter() Iterable[[]T] {
+	return product.Clone()
+}
+
+func (product *Product[T]) current() *[]T {
+	values := make([]T, len(product.indices))
+	for i, index := range product.indices {
+		values[i] = product.pools[i][index]
+	}
+
+	return &values
+}
+
+// NewPermutations returns a new iterator that yields all the ordered
+// arrangements of r values of another iterator.
+//
+// The iterator is consumed and buffered when the permutations are created.
+//
+// This function is only intended to be used by the top level Permutations
+// method.
+func NewPermutations[T any](iter Iterable[T], r uint) *Permutations[T] {
+	return newPermutations(buffer(iter), r)
+}
+
+func newPermutations[T any](pool []T, r uint) *Permutations[T] {
+	n := len(pool)
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+
+	cycles := make([]int, 0, r)
+	for i := 0; i < int(r) && i < n; i++ {
+		cycles = append(cycles, n-i)
+	}
+
+	permutations := &Permutations[T]{pool, r, indices, cycles, false, int(r) > n, Iterator[[]T]{}}
+	permutations.Iterator.iterable = permutations
+	return permutations
+}
+
+// Permutations is an iterator that yields all the ordered arrangements of r
+// values of another iterator, in lexicographic order of their positions.
+//
+// Values are treated as unique based on their position, not on their value.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Permutations method.
+type Permutations[T any] struct {
+	pool    []T
+	r       uint
+	indices []int
+	cycles  []int
+	started bool
+	done    bool
+
+	Iterator[[]T]
+}
+
+// Returns a new slice with the next permutation.
+//
+// If there are no more permutations, nil is returned.
+//
+// # Example
+//
+//	permutations := itertools.Permutations[int](itertools.AsIter([]int{1, 2, 3}), 2)
+//
+//	assert.Equal(t, [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}}, permutations.Collect())
+func (permutations *Permutations[T]) Next() *[]T {
+	if permutations.done {
+		return nil
+	}
+
+	if !permutations.started {
+		permutations.started = true
+		return permutations.current()
+	}
+
+	n := len(permutations.pool)
+
+	for i := int(permutations.r) - 1; i >= 0; i-- {
+		permutations.cycles[i]--
+
+		if permutations.cycles[i] == 0 {
+			moved := permutations.indices[i]
+			copy(permutations.indices[i:], permutations.indices[i+1:])
+			permutations.indices[n-1] = moved
+			permutations.cycles[i] = n - i
+			continue
+		}
+
+		j := n - permutations.cycles[i]
+		permutations.indices[i], permutations.indices[j] = permutations.indices[j], permutations.indices[i]
+		return permutations.current()
+	}
+
+	permutations.done = true
+	return nil
+}
+
+// Returns the total number of permutations yielded by the iterator, that is
+// n! / (n - r)!.
+//
+// The result saturates at math.MaxUint64 if it does not fit in an uint64.
+func (permutations *Permutations[T]) Len() uint64 {
+	n := uint64(len(permutations.pool))
+	r := uint64(permutations.r)
+
+	if r > n {
+		return 0
+	}
+
+	count := uint64(1)
+	for i := n - r + 1; i <= n; i++ {
+		count = mulSat(count, i)
+	}
+
+	return count
+}
+
+// Returns a new iterator with the same values as the original, starting from
+// the first permutation.
+func (permutations *Permutations[T]) Clone() *Permutations[T] {
+	return newPermutations(permutations.pool, permutations.r)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (permutations *Permutations[T]) cloneIter() Iterable[[]T] {
+	return permutations.Clone()
+}
+
+func (permutations *Permutations[T]) current() *[]T {
+	values := make([]T, permutations.r)
+	for i := range values {
+		values[i] = permutations.pool[permutations.indices[i]]
+	}
+
+	return &values
+}
+
+// NewCombinations returns a new iterator that yields all the subsets of r
+// values of another iterator, keeping the order of the values.
+//
+// If replacement is true, each value can be repeated in the same subset.
+// The iterator is consumed and buffered when the combinations are created.
+//
+// This function is only intended to be used by the top level Combinations
+// and CombinationsWithReplacement methods.
+func NewCombinations[T any](iter Iterable[T], r uint, replacement bool) *Combinations[T] {
+	return newCombinations(buffer(iter), r, replacement)
+}
+
+func newCombinations[T any](pool []T, r uint, replacement bool) *Combinations[T] {
+	n := len(pool)
+	indices := make([]int, r)
+	done := int(r) > n
+
+	if replacement {
+		done = n == 0 && r > 0
+	} else {
+		for i := range indices {
+			indices[i] = i
+		}
+	}
+
+	combinations := &Combinations[T]{pool, r, replacement, indices, false, done, Iterator[[]T]{}}
+	combinations.Iterator.iterable = combinations
+	return combinations
+}
+
+// Combinations is an iterator that yields all the subsets of r values of
+// another iterator, in lexicographic order of their positions.
+//
+// Values are treated as unique based on their position, not on their value.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Combinations and CombinationsWithReplacement methods.
+type Combinations[T any] struct {
+	pool        []T
+	r           uint
+	replacement bool
+	indices     []int
+	started     bool
+	done        bool
+
+	Iterator[[]T]
+}
+
+// Returns a new slice with the next combination.
+//
+// If there are no more combinations, nil is returned.
+//
+// # Example
+//
+//	combinations := itertools.Combinations[int](itertools.AsIter([]int{1, 2, 3}), 2)
+//
+//	assert.Equal(t, [][]int{{1, 2}, {1, 3}, {2, 3}}, combinations.Collect())
+func (combinations *Combinations[T]) Next() *[]T {
+	if combinations.done {
+		return nil
+	}
+
+	if !combinations.started {
+		combinations.started = true
+		return combinations.current()
+	}
+
+	n, r := len(combinations.pool), int(combinations.r)
+
+	for i := r - 1; i >= 0; i-- {
+		last := i + n - r
+		if combinations.replacement {
+			last = n - 1
+		}
+
+		if combinations.indices[i] == last {
+			continue
+		}
+
+		combinations.indices[i]++
+		for j := i + 1; j < r; j++ {
+			combinations.indices[j] = combinations.indices[j-1]
+			if !combinations.replacement {
+				combinations.indices[j]++
+			}
+		}
+
+		return combinations.current()
+	}
+
+	combinations.done = true
+	return nil
+}
+
+// Returns the total number of combinations yielded by the iterator, that is
+// the binomial coefficient C(n, r), or C(n + r - 1, r) with replacement.
+//
+// The result saturates at math.MaxUint64 if it does not fit in an uint64.
+func (combinations *Combinations[T]) Len() uint64 {
+	n := uint64(len(combinations.pool))
+	r := uint64(combinations.r)
+
+	if combinations.replacement {
+		if n == 0 {
+			if r == 0 {
+				return 1
+			}
+
+			return 0
+		}
+
+		return binomial(n+r-1, r)
+	}
+
+	return binomial(n, r)
+}
+
+// Returns a new iterator with the same values as the original, starting from
+// the first combination.
+func (combinations *Combinations[T]) Clone() *Combinations[T] {
+	return newCombinations(combinations.pool, combinations.r, combinations.replacement)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (combinations *Combinations[T]) cloneIter() Iterable[[]T] {
+	return combinations.Clone()
+}
+
+func (combinations *Combinations[T]) current() *[]T {
+	values := make([]T, combinations.r)
+	for i := range values {
+		values[i] = combinations.pool[combinations.indices[i]]
+	}
+
+	return &values
+}
+
+// NewPowerset returns a new iterator that yields all the subsets of the values
+// of another iterator.
+//
+// The iterator is consumed and buffered when the powerset is created.
+//
+// This function is only intended to be used by the top level Powerset method.
+func NewPowerset[T any](iter Iterable[T]) *Powerset[T] {
+	return newPowerset(buffer(iter))
+}
+
+func newPowerset[T any](pool []T) *Powerset[T] {
+	powerset := &Powerset[T]{pool, newCombinations(pool, 0, false), Iterator[[]T]{}}
+	powerset.Iterator.iterable = powerset
+	return powerset
+}
+
+// Powerset is an iterator that yields all the subsets of the values of
+// another iterator, from the empty subset to the subset with all the values,
+// ordered by size.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Powerset method.
+type Powerset[T any] struct {
+	pool         []T
+	combinations *Combinations[T]
+
+	Iterator[[]T]
+}
+
+// Returns a new slice with the next subset.
+//
+// If there are no more subsets, nil is returned.
+//
+// # Example
+//
+//	powerset := itertools.Powerset[int](itertools.AsIter([]int{1, 2}))
+//
+//	assert.Equal(t, [][]int{{}, {1}, {2}, {1, 2}}, powerset.Collect())
+func (powerset *Powerset[T]) Next() *[]T {
+	for {
+		if v := powerset.combinations.Next(); v != nil {
+			return v
+		}
+
+		if int(powerset.combinations.r) >= len(powerset.pool) {
+			return nil
+		}
+
+		powerset.combinations = newCombinations(powerset.pool, powerset.combinations.r+1, false)
+	}
+}
+
+// Returns the total number of subsets yielded by the iterator, that is 2^n.
+//
+// The result saturates at math.MaxUint64 if it does not fit in an uint64.
+func (powerset *Powerset[T]) Len() uint64 {
+	if len(powerset.pool) >= 64 {
+		return math.MaxUint64
+	}
+
+	return uint64(1) << len(powerset.pool)
+}
+
+// Returns a new iterator with the same values as the original, starting from
+// the empty subset.
+func (powerset *Powerset[T]) Clone() *Powerset[T] {
+	return newPowerset(powerset.pool)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (powerset *Powerset[T]) cloneIter() Iterable[[]T] {
+	return powerset.Clone()
+}
+
+// Consumes the iterator and returns its values in a slice.
+func buffer[T any](iter Iterable[T]) []T {
+	values := make([]T, 0)
+
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		values = append(values, *v)
+	}
+
+	return values
+}
+
+// Returns the binomial coefficient C(n, k), or math.MaxUint64 if it does not
+// fit in an uint64.
+func binomial(n, k uint64) uint64 {
+	if k > n {
+		return 0
+	}
+
+	if k > n-k {
+		k = n - k
+	}
+
+	count := uint64(1)
+	for i := uint64(0); i < k; i++ {
+		// count*(n-i) is C(n, i+1)*(i+1), so it is computed with 128 bits. If
+		// C(n, i+1) doesn't fit, neither does C(n, k), as k <= n/2.
+		hi, lo := bits.Mul64(count, n-i)
+		if hi >= i+1 {
+			return math.MaxUint64
+		}
+
+		count, _ = bits.Div64(hi, lo, i+1)
+	}
+
+	return count
+}
+
+// Returns a * b, or math.MaxUint64 if it does not fit in an uint64.
+func mulSat(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	if hi != 0 {
+		return math.MaxUint64
+	}
+
+	return lo
+}
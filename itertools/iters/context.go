@@ -0,0 +1,73 @@
+package iters
+
+import "context"
+
+// NewContext returns a new iterator that yields the values of another
+// iterator until a context is done.
+//
+// This function is only intended to be used by the top level WithContext
+// method.
+func NewContext[T any](ctx context.Context, iter Iterable[T]) *Context[T] {
+	c := &Context[T]{ctx, iter, nil, Iterator[T]{}}
+	c.Iterator.iterable = c
+	return c
+}
+
+// Context is an iterator that stops yielding values once a context is done.
+//
+// This struct is not intended to be used directly, is created by the top
+// level WithContext method.
+type Context[T any] struct {
+	ctx  context.Context
+	iter Iterable[T]
+	err  error
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value, if the context is not
+// done yet.
+//
+// If the context is done, or there are no more values, nil is returned. Use
+// Err to tell apart both cases.
+//
+// # Example
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	iter := itertools.WithContext[int](ctx, itertools.Count(0))
+//
+//	assert.Equal(t, 0, *iter.Next())
+//	cancel()
+//	assert.Nil(t, iter.Next())
+//	assert.ErrorIs(t, iter.Err(), context.Canceled)
+func (c *Context[T]) Next() *T {
+	if c.err != nil {
+		return nil
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		return nil
+	}
+
+	return c.iter.Next()
+}
+
+// Returns the error of the context if the iteration was stopped because the
+// context is done, or nil otherwise.
+func (c *Context[T]) Err() error {
+	return c.err
+}
+
+// Returns a new iterator with the same values as the original, bound to the
+// same context.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (c *Context[T]) Clone() *Context[T] {
+	return NewContext(c.ctx, clone(c.iter))
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (c *Context[T]) cloneIter() Iterable[T] {
+	return c.Clone()
+}
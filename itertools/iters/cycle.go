@@ -1,7 +1,13 @@
 package iters
 
-func NewCycle[T any, E CloneableIter[T, E]](iter E) *Cycle[T, E] {
-	cycle := &Cycle[T, E]{*iter.Clone(), iter, Iterator[T]{}}
+// NewCycle returns a new iterator that repeats the values of another iterator
+// indefinitely, cloning it each time it is exhausted.
+//
+// The iterator must be cloneable, otherwise it panics.
+//
+// This function is only intended to be used by the top level Cycle method.
+func NewCycle[T any](iter Iterable[T]) *Cycle[T] {
+	cycle := &Cycle[T]{iter, clone(iter), Iterator[T]{}}
 	cycle.Iterator.iterable = cycle
 	return cycle
 }
@@ -9,8 +15,8 @@ func NewCycle[T any, E CloneableIter[T, E]](iter E) *Cycle[T, E] {
 // Repeats an iterator indefinitely.
 //
 // This struct is only used internally by the Cycle method from iterators.
-type Cycle[T any, E CloneableIter[T, E]] struct {
-	orig E
+type Cycle[T any] struct {
+	orig Iterable[T]
 	iter Iterable[T]
 
 	Iterator[T]
@@ -32,12 +38,12 @@ type Cycle[T any, E CloneableIter[T, E]] struct {
 //		assert.Equal(t, 3, *cycle.Next())
 //		assert.Equal(t, 1, *cycle.Next())
 //	 // ...
-func (cycle *Cycle[T, E]) Next() *T {
+func (cycle *Cycle[T]) Next() *T {
 	if v := cycle.iter.Next(); v != nil {
 		return v
 	}
 
-	cycle.iter = *cycle.orig.Clone()
+	cycle.iter = clone(cycle.orig)
 	return cycle.iter.Next()
 }
 
@@ -57,6 +63,11 @@ func (cycle *Cycle[T, E]) Next() *T {
 //		assert.Equal(t, *cycle.Next(), *clonned.Next())
 //		assert.Equal(t, *cycle.Next(), *clonned.Next())
 //	 // Endless, you get the point...
-func (cycle *Cycle[T, E]) Clone() *Cycle[T, E] {
-	return NewCycle[T](cycle.orig)
+func (cycle *Cycle[T]) Clone() *Cycle[T] {
+	return NewCycle(cycle.orig)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (cycle *Cycle[T]) cloneIter() Iterable[T] {
+	return cycle.Clone()
 }
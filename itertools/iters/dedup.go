@@ -0,0 +1,262 @@
+package iters
+
+import "github.com/skylissh/std-go/cmp"
+
+// NewDedup returns a new iterator that skips the values of another iterator
+// that are equal to the previous value.
+//
+// This function is only intended to be used by the DedupWith method.
+func NewDedup[T any](iter Iterable[T], equal func(value, other T) bool) *Dedup[T] {
+	dedup := &Dedup[T]{iter, equal, nil, Iterator[T]{}}
+	dedup.Iterator.iterable = dedup
+	return dedup
+}
+
+// Dedup is an iterator that removes consecutive duplicates of another
+// iterator.
+//
+// This struct is not intended to be used directly, is created by the
+// DedupWith method.
+type Dedup[T any] struct {
+	iter  Iterable[T]
+	equal func(value, other T) bool
+	last  *T
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value that is not equal to the
+// previous one.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"a", "A", "b"})
+//	dedup := iter.DedupWith(insensitive)
+//
+//	assert.Equal(t, []string{"a", "b"}, dedup.Collect())
+func (dedup *Dedup[T]) Next() *T {
+	for v := dedup.iter.Next(); v != nil; v = dedup.iter.Next() {
+		if dedup.last != nil && dedup.equal(*dedup.last, *v) {
+			continue
+		}
+
+		last := *v
+		dedup.last = &last
+		return v
+	}
+
+	return nil
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (dedup *Dedup[T]) Clone() *Dedup[T] {
+	return NewDedup(clone(dedup.iter), dedup.equal)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (dedup *Dedup[T]) cloneIter() Iterable[T] {
+	return dedup.Clone()
+}
+
+// NewDedupBy returns a new iterator that skips the values of another iterator
+// whose key is equal to the key of the previous value.
+//
+// This function is only intended to be used by the top level Dedup and
+// DedupBy methods.
+func NewDedupBy[T any, K comparable](iter Iterable[T], key func(T) K) *DedupBy[T, K] {
+	dedup := &DedupBy[T, K]{iter, key, nil, Iterator[T]{}}
+	dedup.Iterator.iterable = dedup
+	return dedup
+}
+
+// DedupBy is an iterator that removes consecutive values with the same key
+// from another iterator.
+//
+// The key of each value is computed once, and the key of the last value is
+// kept to compare it with the next one.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Dedup and DedupBy methods.
+type DedupBy[T any, K comparable] struct {
+	iter Iterable[T]
+	key  func(T) K
+	last *K
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value whose key is not equal to
+// the key of the previous value.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"apple", "avocado", "banana"})
+//	dedup := itertools.DedupBy[string](iter, func(v string) byte { return v[0] })
+//
+//	assert.Equal(t, []string{"apple", "banana"}, dedup.Collect())
+func (dedup *DedupBy[T, K]) Next() *T {
+	for v := dedup.iter.Next(); v != nil; v = dedup.iter.Next() {
+		k := dedup.key(*v)
+
+		if dedup.last != nil && *dedup.last == k {
+			continue
+		}
+
+		dedup.last = &k
+		return v
+	}
+
+	return nil
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (dedup *DedupBy[T, K]) Clone() *DedupBy[T, K] {
+	return NewDedupBy(clone(dedup.iter), dedup.key)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (dedup *DedupBy[T, K]) cloneIter() Iterable[T] {
+	return dedup.Clone()
+}
+
+// NewUniqueBy returns a new iterator that skips the values of another iterator
+// whose key was already seen.
+//
+// This function is only intended to be used by the top level Unique and
+// UniqueBy methods.
+func NewUniqueBy[T any, K comparable](iter Iterable[T], key func(T) K) *UniqueBy[T, K] {
+	unique := &UniqueBy[T, K]{iter, key, make(map[K]struct{}), Iterator[T]{}}
+	unique.Iterator.iterable = unique
+	return unique
+}
+
+// UniqueBy is an iterator that removes all the duplicates of another iterator,
+// keeping a set with the keys of the values already seen.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Unique and UniqueBy methods.
+type UniqueBy[T any, K comparable] struct {
+	iter Iterable[T]
+	key  func(T) K
+	seen map[K]struct{}
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value whose key was not seen
+// before.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"a", "B", "A", "b"})
+//	unique := itertools.UniqueBy[string](iter, strings.ToLower)
+//
+//	assert.Equal(t, []string{"a", "B"}, unique.Collect())
+func (unique *UniqueBy[T, K]) Next() *T {
+	for v := unique.iter.Next(); v != nil; v = unique.iter.Next() {
+		k := unique.key(*v)
+
+		if _, ok := unique.seen[k]; !ok {
+			unique.seen[k] = struct{}{}
+			return v
+		}
+	}
+
+	return nil
+}
+
+// Returns a new iterator with the same values as the original, that has not
+// seen any value yet.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (unique *UniqueBy[T, K]) Clone() *UniqueBy[T, K] {
+	return NewUniqueBy(clone(unique.iter), unique.key)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (unique *UniqueBy[T, K]) cloneIter() Iterable[T] {
+	return unique.Clone()
+}
+
+// NewUniqueWith returns a new iterator that skips the values of another
+// iterator that are equal, for the comparator, to a value already seen.
+//
+// This function is only intended to be used by the UniqueWith method.
+func NewUniqueWith[T any](iter Iterable[T], comparator *cmp.Comparator[T]) *UniqueWith[T] {
+	unique := &UniqueWith[T]{iter, comparator, make([]T, 0), Iterator[T]{}}
+	unique.Iterator.iterable = unique
+	return unique
+}
+
+// UniqueWith is an iterator that removes all the duplicates of another
+// iterator, for values that are not comparable with ==.
+//
+// Every value is compared with all the values already seen, so prefer
+// UniqueBy when the values can be mapped to a comparable key.
+//
+// This struct is not intended to be used directly, is created by the
+// UniqueWith method.
+type UniqueWith[T any] struct {
+	iter       Iterable[T]
+	comparator *cmp.Comparator[T]
+	seen       []T
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value that is not equal to any
+// value seen before.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	byLength := cmp.By(func(value, other []int) int {
+//		return len(value) - len(other)
+//	})
+//	iter := itertools.AsIter([][]int{{1}, {2, 3}, {4}})
+//
+//	assert.Equal(t, [][]int{{1}, {2, 3}}, iter.UniqueWith(byLength).Collect())
+func (unique *UniqueWith[T]) Next() *T {
+	for v := unique.iter.Next(); v != nil; v = unique.iter.Next() {
+		c := unique.comparator.Is(*v)
+		seen := false
+
+		for _, s := range unique.seen {
+			if c.Equal(s) {
+				seen = true
+				break
+			}
+		}
+
+		if !seen {
+			unique.seen = append(unique.seen, *v)
+			return v
+		}
+	}
+
+	return nil
+}
+
+// Returns a new iterator with the same values as the original, that has not
+// seen any value yet.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (unique *UniqueWith[T]) Clone() *UniqueWith[T] {
+	return NewUniqueWith(clone(unique.iter), unique.comparator)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (unique *UniqueWith[T]) cloneIter() Iterable[T] {
+	return unique.Clone()
+}
@@ -0,0 +1,40 @@
+package iters_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/skylissh/std-go/cmp"
+	"github.com/skylissh/std-go/itertools/iters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDedupBy(t *testing.T) {
+	iter := iters.NewIter(&[]string{"apple", "avocado", "banana", "apricot"})
+	calls := 0
+	dedup := iters.NewDedupBy[string](iter, func(v string) byte {
+		calls++
+		return v[0]
+	})
+
+	assert.Equal(t, []string{"apple", "banana", "apricot"}, dedup.Collect())
+	assert.Equal(t, 4, calls)
+}
+
+func TestDedupWith(t *testing.T) {
+	iter := iters.NewIter(&[]string{"a", "A", "b", "B", "a"})
+	insensitive := cmp.By(func(value, other string) int {
+		return strings.Compare(strings.ToLower(value), strings.ToLower(other))
+	})
+
+	assert.Equal(t, []string{"a", "b", "a"}, iter.DedupWith(insensitive).Collect())
+}
+
+func TestUniqueWith(t *testing.T) {
+	byLength := cmp.By(func(value, other []int) int {
+		return len(value) - len(other)
+	})
+	iter := iters.NewIter(&[][]int{{1}, {2, 3}, {4}, {5, 6, 7}, {8, 9}})
+
+	assert.Equal(t, [][]int{{1}, {2, 3}, {5, 6, 7}}, iter.UniqueWith(byLength).Collect())
+}
@@ -0,0 +1,62 @@
+package iters
+
+// NewEnumerate returns a new iterator that yields pairs with the index and
+// the value of another iterator, starting to count from start.
+//
+// This function is only intended to be used by the top level Enumerate and
+// EnumerateFrom methods.
+func NewEnumerate[T any](iter Iterable[T], start int) *Enumerate[T] {
+	enumerate := &Enumerate[T]{iter, start, start, Iterator[Pair[int, T]]{}}
+	enumerate.Iterator.iterable = enumerate
+	return enumerate
+}
+
+// Enumerate is an iterator that yields pairs with the index and the value of
+// another iterator.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Enumerate and EnumerateFrom methods.
+type Enumerate[T any] struct {
+	iter  Iterable[T]
+	start int
+	index int
+
+	Iterator[Pair[int, T]]
+}
+
+// Advances the iterator and returns a pair with the index and the next value.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"a", "b"})
+//	enumerate := itertools.Enumerate[string](iter)
+//
+//	assert.Equal(t, iters.NewPair(0, "a"), *enumerate.Next())
+//	assert.Equal(t, iters.NewPair(1, "b"), *enumerate.Next())
+//	assert.Nil(t, enumerate.Next())
+func (enumerate *Enumerate[T]) Next() *Pair[int, T] {
+	next := enumerate.iter.Next()
+
+	if next == nil {
+		return nil
+	}
+
+	pair := &Pair[int, T]{enumerate.index, *next}
+	enumerate.index++
+	return pair
+}
+
+// Returns a new iterator with the same values as the original, counting
+// again from the start index.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (enumerate *Enumerate[T]) Clone() *Enumerate[T] {
+	return NewEnumerate(clone(enumerate.iter), enumerate.start)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (enumerate *Enumerate[T]) cloneIter() Iterable[Pair[int, T]] {
+	return enumerate.Clone()
+}
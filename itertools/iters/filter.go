@@ -67,5 +67,10 @@ func (filter *Filter[T]) Next() *T {
 //	assert.Equal(t, 4, *iter2.Next())
 //	assert.Nil(t, iter2.Next())
 func (filter *Filter[T]) Clone() Iterable[T] {
-	return NewFilter(filter.iter, filter.predicate)
+	return NewFilter(clone(filter.iter), filter.predicate)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (filter *Filter[T]) cloneIter() Iterable[T] {
+	return filter.Clone()
 }
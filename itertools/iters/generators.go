@@ -0,0 +1,297 @@
+package iters
+
+import "golang.org/x/exp/constraints"
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// NewRange returns a new iterator that yields numbers from start, up to but
+// not including stop, advancing step each time.
+//
+// The step can be negative to count down, but it can't be 0, otherwise it
+// panics.
+//
+// This function is only intended to be used by the top level Range method.
+func NewRange[T Number](start, stop, step T) *Range[T] {
+	if step == 0 {
+		panic("The step must not be 0")
+	}
+
+	// Only floating-point types keep the fraction of 1/2.
+	one := T(1)
+	float := one/2 != 0
+
+	r := &Range[T]{start, stop, step, float, start, 0, false, Iterator[T]{}}
+	r.Iterator.iterable = r
+	return r
+}
+
+// Range is an iterator that yields an arithmetic progression of numbers.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Range method.
+type Range[T Number] struct {
+	start T
+	stop  T
+	step  T
+	float bool
+	next  T
+	index T
+	done  bool
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next number of the progression.
+//
+// Floating-point numbers are computed as start + index*step, so they don't
+// accumulate rounding errors. Integers are added the step each time, and the
+// iterator stops before they overflow. If the stop is reached, nil is
+// returned.
+//
+// # Example
+//
+//	r := itertools.Range(0, 10, 3)
+//
+//	assert.Equal(t, []int{0, 3, 6, 9}, r.Collect())
+func (r *Range[T]) Next() *T {
+	value := r.next
+
+	if r.done || (r.step > 0 && value >= r.stop) || (r.step < 0 && value <= r.stop) {
+		r.done = true
+		return nil
+	}
+
+	r.index++
+	if r.float {
+		r.next = r.start + r.index*r.step
+	} else {
+		r.next = value + r.step
+		// The addition wrapped around the limits of the type.
+		r.done = (r.step > 0) != (r.next > value)
+	}
+
+	return &value
+}
+
+// Returns a new iterator with the same values as the original, starting
+// again from start.
+func (r *Range[T]) Clone() *Range[T] {
+	return NewRange(r.start, r.stop, r.step)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (r *Range[T]) cloneIter() Iterable[T] {
+	return r.Clone()
+}
+
+// NewCount returns a new iterator that yields numbers from start, increasing
+// by one each time, indefinitely.
+//
+// This function is only intended to be used by the top level Count method.
+func NewCount[T Number](start T) *Count[T] {
+	count := &Count[T]{start, start, Iterator[T]{}}
+	count.Iterator.iterable = count
+	return count
+}
+
+// Count is an endless iterator that yields consecutive numbers.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Count method.
+type Count[T Number] struct {
+	start T
+	next  T
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next number. Never returns nil.
+//
+// # Example
+//
+//	count := itertools.Count(5)
+//
+//	assert.Equal(t, []int{5, 6, 7}, count.Take(3).Collect())
+func (count *Count[T]) Next() *T {
+	value := count.next
+	count.next++
+	return &value
+}
+
+// Returns a new iterator with the same values as the original, starting
+// again from start.
+func (count *Count[T]) Clone() *Count[T] {
+	return NewCount(count.start)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (count *Count[T]) cloneIter() Iterable[T] {
+	return count.Clone()
+}
+
+// NewRepeat returns a new iterator that yields the same value n times, or
+// indefinitely if infinite is true.
+//
+// This function is only intended to be used by the top level Repeat and
+// RepeatN methods.
+func NewRepeat[T any](value T, n uint, infinite bool) *Repeat[T] {
+	repeat := &Repeat[T]{value, n, n, infinite, Iterator[T]{}}
+	repeat.Iterator.iterable = repeat
+	return repeat
+}
+
+// Repeat is an iterator that yields the same value over and over.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Repeat and RepeatN methods.
+type Repeat[T any] struct {
+	value    T
+	n        uint
+	limit    uint
+	infinite bool
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns a copy of the value.
+//
+// If the value was already repeated n times, nil is returned.
+//
+// # Example
+//
+//	repeat := itertools.RepeatN("a", 2)
+//
+//	assert.Equal(t, "a", *repeat.Next())
+//	assert.Equal(t, "a", *repeat.Next())
+//	assert.Nil(t, repeat.Next())
+func (repeat *Repeat[T]) Next() *T {
+	if !repeat.infinite {
+		if repeat.n == 0 {
+			return nil
+		}
+
+		repeat.n--
+	}
+
+	value := repeat.value
+	return &value
+}
+
+// Returns a new iterator with the same values as the original, that repeats
+// the value n times again.
+func (repeat *Repeat[T]) Clone() *Repeat[T] {
+	return NewRepeat(repeat.value, repeat.limit, repeat.infinite)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (repeat *Repeat[T]) cloneIter() Iterable[T] {
+	return repeat.Clone()
+}
+
+// NewIterate returns a new iterator that yields seed, f(seed), f(f(seed)) and
+// so on, indefinitely.
+//
+// This function is only intended to be used by the top level Iterate method.
+func NewIterate[T any](seed T, f func(T) T) *Iterate[T] {
+	iterate := &Iterate[T]{seed, f, nil, Iterator[T]{}}
+	iterate.Iterator.iterable = iterate
+	return iterate
+}
+
+// Iterate is an endless iterator that yields the successive applications of
+// a function to a seed.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Iterate method.
+type Iterate[T any] struct {
+	seed T
+	f    func(T) T
+	last *T
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the result of applying the function to
+// the previous value. The first value is the seed. Never returns nil.
+//
+// # Example
+//
+//	powers := itertools.Iterate(1, func(v int) int { return v * 2 })
+//
+//	assert.Equal(t, []int{1, 2, 4, 8}, powers.Take(4).Collect())
+func (iterate *Iterate[T]) Next() *T {
+	value := iterate.seed
+	if iterate.last != nil {
+		value = iterate.f(*iterate.last)
+	}
+
+	iterate.last = &value
+	result := value
+	return &result
+}
+
+// Returns a new iterator with the same values as the original, starting
+// again from the seed.
+func (iterate *Iterate[T]) Clone() *Iterate[T] {
+	return NewIterate(iterate.seed, iterate.f)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (iterate *Iterate[T]) cloneIter() Iterable[T] {
+	return iterate.Clone()
+}
+
+// NewFromFunc returns a new iterator that yields the values returned by a
+// function, until it returns false.
+//
+// This function is only intended to be used by the top level FromFunc method.
+func NewFromFunc[T any](f func() (T, bool)) *FromFunc[T] {
+	from := &FromFunc[T]{f, false, Iterator[T]{}}
+	from.Iterator.iterable = from
+	return from
+}
+
+// FromFunc is an iterator that yields the values returned by a function.
+//
+// The function usually keeps state, like a reader, and it can't be replayed,
+// so FromFunc is not cloneable and cloning an adapter that wraps it panics.
+//
+// This struct is not intended to be used directly, is created by the top
+// level FromFunc method.
+type FromFunc[T any] struct {
+	f    func() (T, bool)
+	done bool
+
+	Iterator[T]
+}
+
+// Calls the function and returns its value.
+//
+// Once the function returns false, the iterator is exhausted and the
+// function is not called anymore, so nil is always returned.
+//
+// # Example
+//
+//	n := 0
+//	from := itertools.FromFunc(func() (int, bool) {
+//		n++
+//		return n, n <= 3
+//	})
+//
+//	assert.Equal(t, []int{1, 2, 3}, from.Collect())
+func (from *FromFunc[T]) Next() *T {
+	if from.done {
+		return nil
+	}
+
+	value, ok := from.f()
+	if !ok {
+		from.done = true
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,157 @@
+package iters
+
+// NewGroupBy returns a new iterator that groups the consecutive values of
+// another iterator that share the same key.
+//
+// This function is only intended to be used by the top level GroupBy method.
+func NewGroupBy[T any, K comparable](iter Iterable[T], key func(T) K) *GroupBy[T, K] {
+	group := &GroupBy[T, K]{iter, key, nil, Iterator[Pair[K, []T]]{}}
+	group.Iterator.iterable = group
+	return group
+}
+
+// GroupBy is an iterator that yields pairs with a key and the consecutive
+// values of another iterator that share that key.
+//
+// Only consecutive values are grouped, so the same key can be yielded more
+// than once. This keeps the iterator lazy, and allows to use it with endless
+// iterators.
+//
+// This struct is not intended to be used directly, is created by the top
+// level GroupBy method.
+type GroupBy[T any, K comparable] struct {
+	iter Iterable[T]
+	key  func(T) K
+	// The first value of the next group, already consumed from iter.
+	pending *T
+
+	Iterator[Pair[K, []T]]
+}
+
+// Advances the iterator until the key changes, and returns a pair with the
+// key and the values of the group.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 3, 2, 4, 5})
+//	groups := itertools.GroupBy[int](iter, func(v int) bool { return v%2 == 0 })
+//
+//	assert.Equal(t, iters.NewPair(false, []int{1, 3}), *groups.Next())
+//	assert.Equal(t, iters.NewPair(true, []int{2, 4}), *groups.Next())
+//	assert.Equal(t, iters.NewPair(false, []int{5}), *groups.Next())
+//	assert.Nil(t, groups.Next())
+func (group *GroupBy[T, K]) Next() *Pair[K, []T] {
+	first := group.pending
+	if first == nil {
+		first = group.iter.Next()
+	}
+
+	if first == nil {
+		return nil
+	}
+
+	key := group.key(*first)
+	values := []T{*first}
+	group.pending = nil
+
+	for v := group.iter.Next(); v != nil; v = group.iter.Next() {
+		if group.key(*v) != key {
+			group.pending = v
+			break
+		}
+
+		values = append(values, *v)
+	}
+
+	return &Pair[K, []T]{key, values}
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (group *GroupBy[T, K]) Clone() *GroupBy[T, K] {
+	return NewGroupBy(clone(group.iter), group.key)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (group *GroupBy[T, K]) cloneIter() Iterable[Pair[K, []T]] {
+	return group.Clone()
+}
+
+// NewChunkBy returns a new iterator that splits the values of another
+// iterator into runs, where each pair of consecutive values of a run
+// matches a predicate.
+//
+// This function is only intended to be used by the top level ChunkBy method.
+func NewChunkBy[T any](iter Iterable[T], predicate func(prev, next T) bool) *ChunkBy[T] {
+	chunk := &ChunkBy[T]{iter, predicate, nil, Iterator[[]T]{}}
+	chunk.Iterator.iterable = chunk
+	return chunk
+}
+
+// ChunkBy is an iterator that yields runs of consecutive values of another
+// iterator. A new run starts every time the predicate returns false for the
+// previous and the next value.
+//
+// This struct is not intended to be used directly, is created by the top
+// level ChunkBy method.
+type ChunkBy[T any] struct {
+	iter      Iterable[T]
+	predicate func(prev, next T) bool
+	// The first value of the next run, already consumed from iter.
+	pending *T
+
+	Iterator[[]T]
+}
+
+// Advances the iterator until the predicate returns false, and returns the
+// values of the run.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 5, 6, 8})
+//	runs := itertools.ChunkBy[int](iter, func(prev, next int) bool {
+//		return next == prev+1
+//	})
+//
+//	assert.Equal(t, [][]int{{1, 2, 3}, {5, 6}, {8}}, runs.Collect())
+func (chunk *ChunkBy[T]) Next() *[]T {
+	first := chunk.pending
+	if first == nil {
+		first = chunk.iter.Next()
+	}
+
+	if first == nil {
+		return nil
+	}
+
+	values := []T{*first}
+	chunk.pending = nil
+
+	for v := chunk.iter.Next(); v != nil; v = chunk.iter.Next() {
+		if !chunk.predicate(values[len(values)-1], *v) {
+			chunk.pending = v
+			break
+		}
+
+		values = append(values, *v)
+	}
+
+	return &values
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (chunk *ChunkBy[T]) Clone() *ChunkBy[T] {
+	return NewChunkBy(clone(chunk.iter), chunk.predicate)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (chunk *ChunkBy[T]) cloneIter() Iterable[[]T] {
+	return chunk.Clone()
+}
@@ -47,3 +47,8 @@ func (iter *Iter[T]) Next() *T {
 func (iter *Iter[T]) Clone() *Iter[T] {
 	return NewIter(iter.values)
 }
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (iter *Iter[T]) cloneIter() Iterable[T] {
+	return iter.Clone()
+}
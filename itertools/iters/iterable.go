@@ -11,6 +11,29 @@ type Iterable[T any] interface {
 	Next() *T
 }
 
+// TryIterable is an interface that describes an iterator whose values can
+// fail to be produced, like the rows of a database cursor or the lines of a
+// file.
+//
+// You can implement this interface to create your own fallible iterator.
+type TryIterable[T any] interface {
+	// Returns the next value of the iterator, and advances the iterator.
+	//
+	// If the iterator is empty, it returns nil and a nil error. If the value
+	// could not be produced, it returns nil and the error.
+	Next() (*T, error)
+}
+
+// Fallible is an Iterable that stops yielding values when an error happens,
+// and reports the error with the Err method.
+type Fallible[T any] interface {
+	Iterable[T]
+
+	// Returns the error that stopped the iterator, or nil if the iterator
+	// was exhausted without errors.
+	Err() error
+}
+
 type Cloneable[T any] interface {
 	// Return a new iterator with the same values as the original iterator.
 	//
@@ -22,3 +45,28 @@ type CloneableIter[T, E any] interface {
 	Iterable[T]
 	Cloneable[E]
 }
+
+// cloner is implemented by the iterators of this package, so the adapters can
+// clone the iterators they wrap without knowing their concrete type.
+type cloner[T any] interface {
+	cloneIter() Iterable[T]
+}
+
+// Returns an independent copy of the iterator.
+//
+// This is used by the adapters to clone the iterators they wrap. Iterators
+// from other packages must have a Clone method that returns an Iterable[T] to
+// be cloned, otherwise it panics, as sharing the iterator would make the clone
+// consume the values of the original.
+func clone[T any](iter Iterable[T]) Iterable[T] {
+	switch c := iter.(type) {
+	case nil:
+		return nil
+	case cloner[T]:
+		return c.cloneIter()
+	case interface{ Clone() Iterable[T] }:
+		return c.Clone()
+	}
+
+	panic("The iterator is not cloneable")
+}
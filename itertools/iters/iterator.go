@@ -1,5 +1,11 @@
 package iters
 
+import (
+	"context"
+
+	"github.com/skylissh/std-go/cmp"
+)
+
 // Iterator is the base struct for all iterators.
 //
 // You can use it to create your own iterators, only remember to implement
@@ -8,6 +14,15 @@ type Iterator[T any] struct {
 	iterable Iterable[T]
 }
 
+// NewIterator returns a new Iterator that yields the values of another
+// Iterable, to use the methods of Iterator with it.
+//
+// This function is only intended to be used by the top level methods that
+// reuse the methods of Iterator.
+func NewIterator[T any](iter Iterable[T]) *Iterator[T] {
+	return &Iterator[T]{iter}
+}
+
 // Next returns the next value of the iterator, and advances the iterator.
 //
 // If the iterator is empty, it returns nil.
@@ -32,9 +47,40 @@ func (iter *Iterator[T]) ForEach(f func(T)) {
 	}
 }
 
+// Iterates over the iterator and calls the function f for each value, until
+// the iterator is exhausted or the context is done.
+//
+// Returns the error of the context if it is done before the iterator is
+// exhausted, or nil otherwise.
+//
+// # Example
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//
+//	err := rows.ForEachCtx(ctx, func(row Row) {
+//		process(row)
+//	})
+func (iter *Iterator[T]) ForEachCtx(ctx context.Context, f func(T)) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		v := iter.Next()
+		if v == nil {
+			return nil
+		}
+
+		f(*v)
+	}
+}
+
 // Reduce the iterator to a single value, applying the function f to each value.
 //
 // The result of the function f is used as the accumulator for the next iteration.
+// If the iterator is empty, the zero value of T is returned, use TryReduce to
+// tell apart that case.
 //
 // # Example
 //
@@ -45,13 +91,53 @@ func (iter *Iterator[T]) ForEach(f func(T)) {
 //
 //	assert.Equal(t, 55, sum)
 func (iter *Iterator[T]) Reduce(f func(acc T, value T) T) T {
-	acc := *iter.Next()
+	acc, _ := iter.TryReduce(f)
+	return acc
+}
 
+// Reduce the iterator to a single value, applying the function f to each value.
+//
+// The first value is used as the initial accumulator. Returns false if the
+// iterator is empty.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{})
+//	_, ok := iter.TryReduce(func(acc int, value int) int {
+//		return acc + value
+//	})
+//
+//	assert.False(t, ok)
+func (iter *Iterator[T]) TryReduce(f func(acc T, value T) T) (T, bool) {
+	first := iter.Next()
+
+	if first == nil {
+		var zero T
+		return zero, false
+	}
+
+	acc := *first
 	iter.ForEach(func(value T) {
 		acc = f(acc, value)
 	})
 
-	return acc
+	return acc, true
+}
+
+// Returns a new iterator that yields the running result of applying the
+// function f to the accumulated value and each value. The first value is
+// yielded as is.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4})
+//	sums := iter.Accumulate(func(acc int, value int) int {
+//		return acc + value
+//	})
+//
+//	assert.Equal(t, []int{1, 3, 6, 10}, sums.Collect())
+func (iter *Iterator[T]) Accumulate(f func(acc T, value T) T) *Accumulate[T] {
+	return NewAccumulate(iter.iterable, f)
 }
 
 // Returns a new iterator that contains the values of the original iterator
@@ -96,6 +182,194 @@ func (iter *Iterator[T]) Take(n uint) *Take[T] {
 	return NewTake(iter.iterable, n)
 }
 
+// Returns a new iterator that yields the values of the original iterator
+// while they match the predicate.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 1})
+//
+//	assert.Equal(t, []int{1, 2}, iter.TakeWhile(func(v int) bool {
+//		return v < 3
+//	}).Collect())
+func (iter *Iterator[T]) TakeWhile(predicate func(T) bool) *TakeWhile[T] {
+	return NewTakeWhile(iter.iterable, predicate)
+}
+
+// Returns a new iterator that skips the first n values of the original
+// iterator.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3})
+//
+//	assert.Equal(t, []int{3}, iter.Skip(2).Collect())
+func (iter *Iterator[T]) Skip(n uint) *Skip[T] {
+	return NewSkip(iter.iterable, n)
+}
+
+// Returns a new iterator that skips the values of the original iterator
+// while they match the predicate.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 1})
+//
+//	assert.Equal(t, []int{3, 1}, iter.SkipWhile(func(v int) bool {
+//		return v < 3
+//	}).Collect())
+func (iter *Iterator[T]) SkipWhile(predicate func(T) bool) *SkipWhile[T] {
+	return NewSkipWhile(iter.iterable, predicate)
+}
+
+// Returns a new iterator that yields the first value of the original
+// iterator, and then every step-th value.
+//
+// Panics if step is 0.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4, 5})
+//
+//	assert.Equal(t, []int{1, 3, 5}, iter.StepBy(2).Collect())
+func (iter *Iterator[T]) StepBy(step uint) *StepBy[T] {
+	return NewStepBy(iter.iterable, step)
+}
+
+// Returns a new iterator that yields slices with n values of the original
+// iterator. The last slice may have fewer than n values.
+//
+// The result only has the Next method, as a method of Iterator[T] can't
+// return an Iterator[[]T]. Use the top level Chunks method to get all the
+// methods of an iterator. Panics if n is 0.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4, 5})
+//	chunks := iter.Chunks(2)
+//
+//	assert.Equal(t, []int{1, 2}, *chunks.Next())
+//	assert.Equal(t, []int{3, 4}, *chunks.Next())
+//	assert.Equal(t, []int{5}, *chunks.Next())
+//	assert.Nil(t, chunks.Next())
+func (iter *Iterator[T]) Chunks(n uint) Iterable[[]T] {
+	return newChunker(iter.iterable, n, false)
+}
+
+// Returns a new iterator that yields slices with exactly n values of the
+// original iterator. The remaining values that don't fill a slice are dropped.
+//
+// Like Chunks, the result only has the Next method. Panics if n is 0.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4, 5})
+//	chunks := iter.ChunksExact(2)
+//
+//	assert.Equal(t, []int{1, 2}, *chunks.Next())
+//	assert.Equal(t, []int{3, 4}, *chunks.Next())
+//	assert.Nil(t, chunks.Next())
+func (iter *Iterator[T]) ChunksExact(n uint) Iterable[[]T] {
+	return newChunker(iter.iterable, n, true)
+}
+
+// Returns a new iterator that yields overlapping slices with n values of the
+// original iterator, advancing one value each time. Each window is a new
+// slice.
+//
+// Like Chunks, the result only has the Next method, use the top level Windows
+// method to get all the methods of an iterator. Panics if n is 0.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4})
+//	windows := iter.Windows(3)
+//
+//	assert.Equal(t, []int{1, 2, 3}, *windows.Next())
+//	assert.Equal(t, []int{2, 3, 4}, *windows.Next())
+//	assert.Nil(t, windows.Next())
+func (iter *Iterator[T]) Windows(n uint) Iterable[[]T] {
+	return &windower[T]{NewWindowsView(iter.iterable, n)}
+}
+
+// Returns a new iterator that yields the values of the original iterator
+// from the index start, up to but not including the index stop, advancing
+// step values each time. It works like the slice expression values[start:stop]
+// but with a step, and without materializing the values.
+//
+// Panics if step is 0.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{0, 1, 2, 3, 4, 5, 6})
+//
+//	assert.Equal(t, []int{1, 3, 5}, iter.Slice(1, 6, 2).Collect())
+func (iter *Iterator[T]) Slice(start, stop, step uint) *Slice[T] {
+	return NewSlice(iter.iterable, start, stop, step)
+}
+
+// Returns a new iterator that skips the values that are equal to the previous
+// value, using the comparator to compare them.
+//
+// Use the top level Dedup method for values that are comparable with ==.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"a", "A", "b"})
+//	insensitive := cmp.By(func(value, other string) int {
+//		return strings.Compare(strings.ToLower(value), strings.ToLower(other))
+//	})
+//
+//	assert.Equal(t, []string{"a", "b"}, iter.DedupWith(insensitive).Collect())
+func (iter *Iterator[T]) DedupWith(comparator *cmp.Comparator[T]) *Dedup[T] {
+	return NewDedup(iter.iterable, func(value, other T) bool {
+		return comparator.Is(value).Equal(other)
+	})
+}
+
+// Returns a new iterator that skips the values that are equal, for the
+// comparator, to any value already seen. This works with values that are
+// not comparable with ==, but compares each value with all the previous ones.
+//
+// # Example
+//
+//	byLength := cmp.By(func(value, other []int) int {
+//		return len(value) - len(other)
+//	})
+//	iter := itertools.AsIter([][]int{{1}, {2, 3}, {4}})
+//
+//	assert.Equal(t, [][]int{{1}, {2, 3}}, iter.UniqueWith(byLength).Collect())
+func (iter *Iterator[T]) UniqueWith(comparator *cmp.Comparator[T]) *UniqueWith[T] {
+	return NewUniqueWith(iter.iterable, comparator)
+}
+
+// Returns a new iterator that allows to look at the next values without
+// consuming them, and to put back up to limit values.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3})
+//	peekable := iter.Peekable(1)
+//
+//	assert.Equal(t, 1, *peekable.Peek())
+//	assert.Equal(t, 1, *peekable.Next())
+func (iter *Iterator[T]) Peekable(limit uint) *Peekable[T] {
+	return NewPeekable(iter.iterable, limit)
+}
+
+// Returns a new iterator that yields the values of the original iterator,
+// followed by the values of each of the given iterators.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2})
+//	chain := iter.Chain(itertools.AsIter([]int{3, 4}), itertools.AsIter([]int{5}))
+//
+//	assert.Equal(t, []int{1, 2, 3, 4, 5}, chain.Collect())
+func (iter *Iterator[T]) Chain(others ...Iterable[T]) *Chain[T] {
+	return NewChain(append([]Iterable[T]{iter.iterable}, others...)...)
+}
+
 // Check if all values of the iterator match the predicate.
 //
 // Returns false at the first value that does not match the predicate,
@@ -180,3 +454,25 @@ func (iter *Iterator[T]) Collect() []T {
 
 	return collect
 }
+
+// Collect the values of the iterator into a slice, until the iterator is
+// exhausted or the context is done.
+//
+// If the context is done first, the values collected so far are returned
+// with the error of the context.
+//
+// # Example
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//
+//	values, err := iter.CollectCtx(ctx)
+func (iter *Iterator[T]) CollectCtx(ctx context.Context) ([]T, error) {
+	collect := make([]T, 0)
+
+	err := iter.ForEachCtx(ctx, func(value T) {
+		collect = append(collect, value)
+	})
+
+	return collect, err
+}
@@ -19,6 +19,36 @@ func TestClone(t *testing.T) {
 	assert.Nil(t, _iter.Next())
 }
 
+// counter is an iterator from outside the package that can be cloned.
+type counter struct{ n int }
+
+func (c *counter) Next() *int {
+	c.n++
+	return &c.n
+}
+
+func (c *counter) Clone() iters.Iterable[int] {
+	return &counter{c.n}
+}
+
+func TestCloneCloneable(t *testing.T) {
+	iter := iters.NewFilter[int](&counter{}, func(v int) bool { return v%2 == 0 })
+	iter.Next()
+
+	assert.Equal(t, 4, *iter.Clone().Next())
+	assert.Equal(t, 4, *iter.Next())
+}
+
+func TestCloneNotCloneable(t *testing.T) {
+	ch := make(chan int)
+	close(ch)
+	iter := iters.NewFilter[int](iters.NewChan(ch), func(v int) bool { return true })
+
+	assert.PanicsWithValue(t, "The iterator is not cloneable", func() {
+		iter.Clone()
+	})
+}
+
 func TestNext(t *testing.T) {
 	iter := _iter.Clone()
 	expect := 1
@@ -117,3 +147,115 @@ func TestFind(t *testing.T) {
 
 	assert.Equal(t, 5, *iter.Find(func(num int) bool { return num == 5 }))
 }
+
+func TestTakeWhile(t *testing.T) {
+	iter := _iter.Clone()
+	expect := []int{1, 2, 3}
+
+	assert.Equal(t, expect, iter.TakeWhile(func(value int) bool {
+		return value < 4
+	}).Collect())
+}
+
+func TestSkip(t *testing.T) {
+	iter := _iter.Clone()
+	expect := []int{8, 9, 10}
+
+	assert.Equal(t, expect, iter.Skip(7).Collect())
+}
+
+func TestSkipMoreThanAvailable(t *testing.T) {
+	iter := _iter.Clone()
+
+	assert.Empty(t, iter.Skip(15).Collect())
+}
+
+func TestSkipWhile(t *testing.T) {
+	iter := _iter.Clone()
+	expect := []int{8, 9, 10}
+
+	assert.Equal(t, expect, iter.SkipWhile(func(value int) bool {
+		return value < 8
+	}).Collect())
+}
+
+func TestStepBy(t *testing.T) {
+	iter := _iter.Clone()
+	expect := []int{1, 4, 7, 10}
+
+	assert.Equal(t, expect, iter.StepBy(3).Collect())
+}
+
+func TestStepByZero(t *testing.T) {
+	assert.Panics(t, func() {
+		_iter.Clone().StepBy(0)
+	})
+}
+
+func TestSlice(t *testing.T) {
+	iter := _iter.Clone()
+	expect := []int{2, 4, 6}
+
+	assert.Equal(t, expect, iter.Slice(1, 7, 2).Collect())
+}
+
+func TestSliceClone(t *testing.T) {
+	slice := _iter.Clone().Skip(2).StepBy(2)
+
+	assert.Equal(t, []int{3, 5, 7}, slice.Take(3).Collect())
+	assert.Equal(t, []int{3, 5, 7}, slice.Clone().Take(3).Collect())
+}
+
+func TestCycleSkip(t *testing.T) {
+	cycle := iters.NewCycle[int](_iter.Clone().Skip(8))
+
+	assert.Equal(t, []int{9, 10, 9, 10}, cycle.Take(4).Collect())
+}
+
+func TestAccumulate(t *testing.T) {
+	iter := _iter.Clone()
+	expect := []int{1, 3, 6, 10, 15}
+
+	assert.Equal(t, expect, iter.Take(5).Accumulate(func(acc, value int) int {
+		return acc + value
+	}).Collect())
+}
+
+func TestReduceEmpty(t *testing.T) {
+	iter := iters.NewIter(&[]int{})
+
+	assert.NotPanics(t, func() {
+		assert.Equal(t, 0, iter.Reduce(func(acc, value int) int {
+			return acc + value
+		}))
+	})
+}
+
+func TestTryReduce(t *testing.T) {
+	iter := _iter.Clone()
+	sum, ok := iter.TryReduce(func(acc, value int) int {
+		return acc + value
+	})
+
+	assert.True(t, ok)
+	assert.Equal(t, 55, sum)
+}
+
+func TestTryReduceEmpty(t *testing.T) {
+	iter := iters.NewIter(&[]int{})
+	_, ok := iter.TryReduce(func(acc, value int) int {
+		return acc + value
+	})
+
+	assert.False(t, ok)
+}
+
+func TestTryReduceSingleton(t *testing.T) {
+	iter := iters.NewIter(&[]int{7})
+	value, ok := iter.TryReduce(func(acc, value int) int {
+		panic("The function must not be called with a single value")
+	})
+
+	assert.True(t, ok)
+	assert.Equal(t, 7, value)
+}
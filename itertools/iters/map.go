@@ -70,5 +70,10 @@ func (m Map[T, E]) Next() *E {
 //		assert.Nil(t, numbers.Next())
 //		assert.Nil(t, clonned.Next())
 func (m Map[T, E]) Clone() Iterable[E] {
-	return NewMap(m.iter, m.f)
+	return NewMap(clone(m.iter), m.f)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (m Map[T, E]) cloneIter() Iterable[E] {
+	return m.Clone()
 }
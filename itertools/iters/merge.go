@@ -0,0 +1,155 @@
+package iters
+
+import (
+	"container/heap"
+
+	"github.com/skylissh/std-go/cmp"
+)
+
+// NewMerge returns a new iterator that merges already sorted iterators into a
+// single sorted iterator.
+//
+// If comparator is nil, the values are compared with cmp.Is. If dedup is true,
+// values that are equal to the previous one are skipped.
+//
+// This function is only intended to be used by the top level MergeSorted and
+// MergeSortedDedup methods.
+func NewMerge[T any](comparator *cmp.Comparator[T], dedup bool, iters ...Iterable[T]) *Merge[T] {
+	compare := func(value, other T) int {
+		return cmp.Is(value).Compare(other)
+	}
+
+	if comparator != nil {
+		compare = func(value, other T) int {
+			return comparator.Is(value).Compare(other)
+		}
+	}
+
+	merge := &Merge[T]{iters, comparator, dedup, mergeHeap[T]{nil, compare}, false, nil, Iterator[T]{}}
+	merge.Iterator.iterable = merge
+	return merge
+}
+
+// Merge is an iterator that merges already sorted iterators into a single
+// sorted iterator.
+//
+// Only the next value of each iterator is kept in memory, in a heap, so the
+// iterators can be arbitrarily large.
+//
+// This struct is not intended to be used directly, is created by the top
+// level MergeSorted and MergeSortedDedup methods.
+type Merge[T any] struct {
+	iters      []Iterable[T]
+	comparator *cmp.Comparator[T]
+	dedup      bool
+	heap       mergeHeap[T]
+	started    bool
+	last       *T
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the smallest of the next values of the
+// merged iterators. Equal values are returned in the order of the iterators.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	a := itertools.AsIter([]int{1, 4, 7})
+//	b := itertools.AsIter([]int{2, 5})
+//	merge := itertools.MergeSorted[int](nil, a, b)
+//
+//	assert.Equal(t, []int{1, 2, 4, 5, 7}, merge.Collect())
+func (merge *Merge[T]) Next() *T {
+	if !merge.started {
+		merge.started = true
+
+		for i, iter := range merge.iters {
+			if v := iter.Next(); v != nil {
+				merge.heap.entries = append(merge.heap.entries, mergeEntry[T]{*v, i})
+			}
+		}
+
+		heap.Init(&merge.heap)
+	}
+
+	for merge.heap.Len() > 0 {
+		entry := merge.heap.entries[0]
+
+		if v := merge.iters[entry.index].Next(); v != nil {
+			merge.heap.entries[0].value = *v
+			heap.Fix(&merge.heap, 0)
+		} else {
+			heap.Pop(&merge.heap)
+		}
+
+		if merge.dedup && merge.last != nil && merge.heap.compare(entry.value, *merge.last) == 0 {
+			continue
+		}
+
+		value := entry.value
+		merge.last = &value
+		return &value
+	}
+
+	return nil
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The merged iterators are also cloned, so it panics if any of them is not
+// cloneable.
+func (merge *Merge[T]) Clone() *Merge[T] {
+	iters := make([]Iterable[T], len(merge.iters))
+	for i, iter := range merge.iters {
+		iters[i] = clone(iter)
+	}
+
+	return NewMerge(merge.comparator, merge.dedup, iters...)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (merge *Merge[T]) cloneIter() Iterable[T] {
+	return merge.Clone()
+}
+
+// The next value of one of the merged iterators, with the index of the
+// iterator it comes from.
+type mergeEntry[T any] struct {
+	value T
+	index int
+}
+
+// A min-heap of the next values of the merged iterators. Equal values are
+// ordered by the index of their iterator, to keep the merge stable.
+type mergeHeap[T any] struct {
+	entries []mergeEntry[T]
+	compare func(value, other T) int
+}
+
+func (h *mergeHeap[T]) Len() int {
+	return len(h.entries)
+}
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	if c := h.compare(h.entries[i].value, h.entries[j].value); c != 0 {
+		return c < 0
+	}
+
+	return h.entries[i].index < h.entries[j].index
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+}
+
+func (h *mergeHeap[T]) Push(entry any) {
+	h.entries = append(h.entries, entry.(mergeEntry[T]))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+	last := h.entries[len(h.entries)-1]
+	h.entries = h.entries[:len(h.entries)-1]
+	return last
+}
@@ -0,0 +1,144 @@
+package iters
+
+import "github.com/skylissh/std-go/cmp"
+
+// Returns the minimum value of the iterator, using cmp.Is to compare the
+// values. If several values are equally minimum, the first one is returned.
+//
+// The values must implement cmp.Comparable[T], or be one of the types
+// supported by cmp.Is, otherwise it panics. Returns false if the iterator is
+// empty.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{3, 1, 2})
+//	min, ok := iter.Min()
+//
+//	assert.True(t, ok)
+//	assert.Equal(t, 1, min)
+func (iter *Iterator[T]) Min() (T, bool) {
+	return iter.best(func(value, best T) bool {
+		return cmp.Is(value).Less(best)
+	})
+}
+
+// Returns the maximum value of the iterator, using cmp.Is to compare the
+// values. If several values are equally maximum, the first one is returned.
+//
+// The values must implement cmp.Comparable[T], or be one of the types
+// supported by cmp.Is, otherwise it panics. Returns false if the iterator is
+// empty.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{3, 1, 2})
+//	max, ok := iter.Max()
+//
+//	assert.True(t, ok)
+//	assert.Equal(t, 3, max)
+func (iter *Iterator[T]) Max() (T, bool) {
+	return iter.best(func(value, best T) bool {
+		return cmp.Is(value).Greater(best)
+	})
+}
+
+// Returns the minimum value of the iterator, using the comparator to compare
+// the values. If several values are equally minimum, the first one is
+// returned.
+//
+// Returns false if the iterator is empty.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"ccc", "a", "bb"})
+//	shortest, _ := iter.MinBy(cmp.By(func(value, other string) int {
+//		return len(value) - len(other)
+//	}))
+//
+//	assert.Equal(t, "a", shortest)
+func (iter *Iterator[T]) MinBy(comparator *cmp.Comparator[T]) (T, bool) {
+	return iter.best(func(value, best T) bool {
+		return comparator.Is(value).Less(best)
+	})
+}
+
+// Returns the maximum value of the iterator, using the comparator to compare
+// the values. If several values are equally maximum, the first one is
+// returned.
+//
+// Returns false if the iterator is empty.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"a", "ccc", "bb"})
+//	longest, _ := iter.MaxBy(cmp.By(func(value, other string) int {
+//		return len(value) - len(other)
+//	}))
+//
+//	assert.Equal(t, "ccc", longest)
+func (iter *Iterator[T]) MaxBy(comparator *cmp.Comparator[T]) (T, bool) {
+	return iter.best(func(value, best T) bool {
+		return comparator.Is(value).Greater(best)
+	})
+}
+
+// Returns the minimum and the maximum values of the iterator in a single pass,
+// using cmp.Is to compare the values.
+//
+// The values must implement cmp.Comparable[T], or be one of the types
+// supported by cmp.Is, otherwise it panics. Returns false if the iterator is
+// empty.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{3, 1, 2})
+//	min, max, ok := iter.MinMax()
+//
+//	assert.True(t, ok)
+//	assert.Equal(t, 1, min)
+//	assert.Equal(t, 3, max)
+func (iter *Iterator[T]) MinMax() (T, T, bool) {
+	first := iter.Next()
+
+	if first == nil {
+		var zero T
+		return zero, zero, false
+	}
+
+	min, max := *first, *first
+	iter.ForEach(func(value T) {
+		c := cmp.Is(value)
+
+		if c.Less(min) {
+			min = value
+		}
+
+		if c.Greater(max) {
+			max = value
+		}
+	})
+
+	return min, max, true
+}
+
+// Returns the first value for which better returns true against every value
+// kept before it.
+//
+// Returns false if the iterator is empty.
+func (iter *Iterator[T]) best(better func(value, best T) bool) (T, bool) {
+	first := iter.Next()
+
+	if first == nil {
+		var zero T
+		return zero, false
+	}
+
+	best := *first
+	iter.ForEach(func(value T) {
+		if better(value, best) {
+			best = value
+		}
+	})
+
+	return best, true
+}
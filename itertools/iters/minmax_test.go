@@ -0,0 +1,61 @@
+package iters_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/cmp"
+	"github.com/skylissh/std-go/itertools/iters"
+	"github.com/stretchr/testify/assert"
+)
+
+var byLength = cmp.By(func(value, other string) int {
+	return len(value) - len(other)
+})
+
+func TestMin(t *testing.T) {
+	min, ok := iters.NewIter(&[]int{3, 1, 2}).Min()
+
+	assert.True(t, ok)
+	assert.Equal(t, 1, min)
+}
+
+func TestMax(t *testing.T) {
+	max, ok := iters.NewIter(&[]int{3, 1, 2}).Max()
+
+	assert.True(t, ok)
+	assert.Equal(t, 3, max)
+}
+
+func TestMinEmpty(t *testing.T) {
+	_, ok := iters.NewIter(&[]int{}).Min()
+
+	assert.False(t, ok)
+}
+
+func TestMinMax(t *testing.T) {
+	min, max, ok := iters.NewIter(&[]string{"b", "c", "a"}).MinMax()
+
+	assert.True(t, ok)
+	assert.Equal(t, "a", min)
+	assert.Equal(t, "c", max)
+}
+
+func TestMinMaxEmpty(t *testing.T) {
+	_, _, ok := iters.NewIter(&[]string{}).MinMax()
+
+	assert.False(t, ok)
+}
+
+func TestMinBy(t *testing.T) {
+	shortest, ok := iters.NewIter(&[]string{"ccc", "a", "b"}).MinBy(byLength)
+
+	assert.True(t, ok)
+	assert.Equal(t, "a", shortest)
+}
+
+func TestMaxBy(t *testing.T) {
+	longest, ok := iters.NewIter(&[]string{"a", "ccc", "ddd"}).MaxBy(byLength)
+
+	assert.True(t, ok)
+	assert.Equal(t, "ccc", longest)
+}
@@ -0,0 +1,146 @@
+package iters
+
+// NewParMap returns a new iterator that maps the values of another iterator
+// concurrently, using up to workers goroutines.
+//
+// If ordered is true, the values are yielded in the order of the original
+// iterator, otherwise as soon as they are mapped. The workers must be greater
+// than 0, otherwise it panics.
+//
+// This function is only intended to be used by the top level ParMap and
+// ParMapUnordered methods.
+func NewParMap[T, E any](iter Iterable[T], f func(T) E, workers uint, ordered bool) *ParMap[T, E] {
+	if workers == 0 {
+		panic("The number of workers must be greater than 0")
+	}
+
+	m := &ParMap[T, E]{
+		iter:    iter,
+		f:       f,
+		workers: workers,
+		ordered: ordered,
+		queue:   make([]chan parResult[E], 0, workers),
+		results: make(chan parResult[E], workers),
+	}
+	m.Iterator.iterable = m
+	return m
+}
+
+// ParMap is an iterator that maps the values of another iterator
+// concurrently.
+//
+// The original iterator is only advanced from the goroutine that calls Next,
+// and at most workers values are being mapped at the same time, so a slow
+// consumer stops the reading of new values.
+//
+// This struct is not intended to be used directly, is created by the top
+// level ParMap and ParMapUnordered methods.
+type ParMap[T, E any] struct {
+	iter    Iterable[T]
+	f       func(T) E
+	workers uint
+	ordered bool
+	done    bool
+
+	// The pending results in order, used when ordered is true.
+	queue []chan parResult[E]
+	// The results as they are ready, used when ordered is false.
+	results  chan parResult[E]
+	inFlight uint
+
+	Iterator[E]
+}
+
+// The result of mapping a value, or the value recovered if the function
+// panicked.
+type parResult[E any] struct {
+	value E
+	panic any
+}
+
+// Advances the iterator and returns the next mapped value.
+//
+// If the function panicked while mapping a value, the panic is propagated
+// when that value would be returned. If there are no more values, nil is
+// returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"a.com", "b.com"})
+//	pages := itertools.ParMap[string](iter, fetch, 8)
+//
+//	for page := pages.Next(); page != nil; page = pages.Next() {
+//		fmt.Println(*page)
+//	}
+func (m *ParMap[T, E]) Next() *E {
+	m.fill()
+
+	if m.inFlight == 0 {
+		return nil
+	}
+
+	var result parResult[E]
+	if m.ordered {
+		result = <-m.queue[0]
+		m.queue = m.queue[1:]
+	} else {
+		result = <-m.results
+	}
+
+	m.inFlight--
+
+	if result.panic != nil {
+		panic(result.panic)
+	}
+
+	return &result.value
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (m *ParMap[T, E]) Clone() *ParMap[T, E] {
+	return NewParMap(clone(m.iter), m.f, m.workers, m.ordered)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (m *ParMap[T, E]) cloneIter() Iterable[E] {
+	return m.Clone()
+}
+
+// Starts mapping values of the original iterator until there are workers
+// values in flight, or the original iterator is exhausted.
+func (m *ParMap[T, E]) fill() {
+	for !m.done && m.inFlight < m.workers {
+		v := m.iter.Next()
+		if v == nil {
+			m.done = true
+			return
+		}
+
+		out := m.results
+		if m.ordered {
+			out = make(chan parResult[E], 1)
+			m.queue = append(m.queue, out)
+		}
+
+		m.inFlight++
+		go m.run(*v, out)
+	}
+}
+
+// Maps a single value and sends the result, recovering the panics of the
+// function so they can be propagated to the consumer.
+func (m *ParMap[T, E]) run(value T, out chan<- parResult[E]) {
+	var result parResult[E]
+
+	defer func() {
+		if r := recover(); r != nil {
+			result.panic = r
+		}
+
+		out <- result
+	}()
+
+	result.value = m.f(value)
+}
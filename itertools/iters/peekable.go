@@ -0,0 +1,163 @@
+package iters
+
+// NewPeekable returns a new iterator that allows to look at the next values of
+// another iterator without consuming them, and to put back up to limit values.
+//
+// This function is only intended to be used by the Peekable method.
+func NewPeekable[T any](iter Iterable[T], limit uint) *Peekable[T] {
+	peekable := &Peekable[T]{iter, make([]T, 0), 0, limit, Iterator[T]{}}
+	peekable.Iterator.iterable = peekable
+	return peekable
+}
+
+// Peekable is an iterator that allows to look at the next values of another
+// iterator without consuming them.
+//
+// The values peeked from the original iterator, and the values put back, are
+// kept in a buffer that is consumed before advancing the original iterator.
+//
+// This struct is not intended to be used directly, is created by the Peekable
+// method.
+type Peekable[T any] struct {
+	iter Iterable[T]
+	buf  []T
+	// The number of values at the front of buf that were put back.
+	back  uint
+	limit uint
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value.
+//
+// If there are no more values, nil is returned.
+func (peekable *Peekable[T]) Next() *T {
+	if len(peekable.buf) == 0 {
+		return peekable.iter.Next()
+	}
+
+	v := peekable.buf[0]
+	peekable.buf = peekable.buf[1:]
+
+	if peekable.back > 0 {
+		peekable.back--
+	}
+
+	return &v
+}
+
+// Returns the next value without advancing the iterator.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2})
+//	peekable := iter.Peekable(1)
+//
+//	assert.Equal(t, 1, *peekable.Peek())
+//	assert.Equal(t, 1, *peekable.Next())
+//	assert.Equal(t, 2, *peekable.Peek())
+func (peekable *Peekable[T]) Peek() *T {
+	if !peekable.fill(1) {
+		return nil
+	}
+
+	v := peekable.buf[0]
+	return &v
+}
+
+// Returns up to k next values without advancing the iterator. Fewer values are
+// returned if the iterator is exhausted before.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3})
+//	peekable := iter.Peekable(1)
+//
+//	assert.Equal(t, []int{1, 2}, peekable.PeekN(2))
+//	assert.Equal(t, 1, *peekable.Next())
+func (peekable *Peekable[T]) PeekN(k uint) []T {
+	peekable.fill(k)
+
+	n := k
+	if uint(len(peekable.buf)) < n {
+		n = uint(len(peekable.buf))
+	}
+
+	return append([]T{}, peekable.buf[:n]...)
+}
+
+// Advances the iterator and returns the next value, only if it matches the
+// predicate. Otherwise the iterator is not advanced and nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]rune("12a"))
+//	peekable := iter.Peekable(1)
+//	isDigit := func(r rune) bool { return unicode.IsDigit(r) }
+//
+//	assert.Equal(t, '1', *peekable.NextIf(isDigit))
+//	assert.Equal(t, '2', *peekable.NextIf(isDigit))
+//	assert.Nil(t, peekable.NextIf(isDigit))
+//	assert.Equal(t, 'a', *peekable.Next())
+func (peekable *Peekable[T]) NextIf(predicate func(T) bool) *T {
+	if v := peekable.Peek(); v == nil || !predicate(*v) {
+		return nil
+	}
+
+	return peekable.Next()
+}
+
+// Puts a value back at the front of the iterator, so it will be the next value
+// returned. At most limit values can be put back before they are consumed
+// again.
+//
+// Returns false if the limit was reached, and the value was not put back.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{2, 3})
+//	peekable := iter.Peekable(1)
+//
+//	assert.True(t, peekable.PutBack(1))
+//	assert.False(t, peekable.PutBack(0))
+//	assert.Equal(t, []int{1, 2, 3}, peekable.Collect())
+func (peekable *Peekable[T]) PutBack(value T) bool {
+	if peekable.back >= peekable.limit {
+		return false
+	}
+
+	peekable.buf = append([]T{value}, peekable.buf...)
+	peekable.back++
+	return true
+}
+
+// Returns a new iterator with the same values as the original, without the
+// values that were put back.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (peekable *Peekable[T]) Clone() *Peekable[T] {
+	return NewPeekable(clone(peekable.iter), peekable.limit)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (peekable *Peekable[T]) cloneIter() Iterable[T] {
+	return peekable.Clone()
+}
+
+// Fills the buffer with values of the original iterator until it has n values.
+//
+// Returns false if the buffer could not be filled.
+func (peekable *Peekable[T]) fill(n uint) bool {
+	for uint(len(peekable.buf)) < n {
+		v := peekable.iter.Next()
+		if v == nil {
+			return false
+		}
+
+		peekable.buf = append(peekable.buf, *v)
+	}
+
+	return true
+}
@@ -0,0 +1,60 @@
+package iters_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeek(t *testing.T) {
+	peekable := _iter.Clone().Peekable(1)
+
+	assert.Equal(t, 1, *peekable.Peek())
+	assert.Equal(t, 1, *peekable.Peek())
+	assert.Equal(t, 1, *peekable.Next())
+	assert.Equal(t, 2, *peekable.Peek())
+}
+
+func TestPeekEmpty(t *testing.T) {
+	peekable := _iter.Clone().Skip(10).Peekable(1)
+
+	assert.Nil(t, peekable.Peek())
+	assert.Nil(t, peekable.Next())
+}
+
+func TestPeekN(t *testing.T) {
+	peekable := _iter.Clone().Take(3).Peekable(1)
+
+	assert.Equal(t, []int{1, 2}, peekable.PeekN(2))
+	assert.Equal(t, []int{1, 2, 3}, peekable.PeekN(5))
+	assert.Equal(t, []int{1, 2, 3}, peekable.Collect())
+}
+
+func TestNextIf(t *testing.T) {
+	peekable := _iter.Clone().Peekable(1)
+	small := func(value int) bool { return value < 3 }
+
+	assert.Equal(t, 1, *peekable.NextIf(small))
+	assert.Equal(t, 2, *peekable.NextIf(small))
+	assert.Nil(t, peekable.NextIf(small))
+	assert.Equal(t, 3, *peekable.Next())
+}
+
+func TestPutBack(t *testing.T) {
+	peekable := _iter.Clone().Take(2).Peekable(2)
+
+	assert.Equal(t, 1, *peekable.Next())
+	assert.True(t, peekable.PutBack(1))
+	assert.True(t, peekable.PutBack(0))
+	assert.False(t, peekable.PutBack(-1))
+	assert.Equal(t, []int{0, 1, 2}, peekable.Collect())
+}
+
+func TestPeekableFilter(t *testing.T) {
+	peekable := _iter.Clone().Peekable(1)
+	peekable.Peek()
+
+	assert.Equal(t, []int{2, 4, 6, 8, 10}, peekable.Filter(func(value int) bool {
+		return value%2 == 0
+	}).Collect())
+}
@@ -0,0 +1,132 @@
+package iters
+
+// NewScan returns a new iterator that yields the intermediate states of
+// folding the values of another iterator, starting from init.
+//
+// This function is only intended to be used by the top level Scan method.
+func NewScan[T, S any](iter Iterable[T], init S, f func(acc S, value T) S) *Scan[T, S] {
+	scan := &Scan[T, S]{iter, init, init, f, Iterator[S]{}}
+	scan.Iterator.iterable = scan
+	return scan
+}
+
+// Scan is an iterator that yields the intermediate states of folding the
+// values of another iterator.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Scan method.
+type Scan[T, S any] struct {
+	iter Iterable[T]
+	init S
+	acc  S
+	f    func(acc S, value T) S
+
+	Iterator[S]
+}
+
+// Advances the iterator, applies the function to the current state and the
+// next value, and returns the new state.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3})
+//	sums := itertools.Scan[int](iter, 0, func(acc, value int) int {
+//		return acc + value
+//	})
+//
+//	assert.Equal(t, 1, *sums.Next())
+//	assert.Equal(t, 3, *sums.Next())
+//	assert.Equal(t, 6, *sums.Next())
+//	assert.Nil(t, sums.Next())
+func (scan *Scan[T, S]) Next() *S {
+	next := scan.iter.Next()
+
+	if next == nil {
+		return nil
+	}
+
+	scan.acc = scan.f(scan.acc, *next)
+	acc := scan.acc
+	return &acc
+}
+
+// Returns a new iterator with the same values as the original, starting again
+// from the initial state.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (scan *Scan[T, S]) Clone() *Scan[T, S] {
+	return NewScan(clone(scan.iter), scan.init, scan.f)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (scan *Scan[T, S]) cloneIter() Iterable[S] {
+	return scan.Clone()
+}
+
+// NewAccumulate returns a new iterator that yields the accumulated values of
+// another iterator, using the first value as the initial state.
+//
+// This function is only intended to be used by the Accumulate method.
+func NewAccumulate[T any](iter Iterable[T], f func(acc, value T) T) *Accumulate[T] {
+	accumulate := &Accumulate[T]{iter, nil, f, Iterator[T]{}}
+	accumulate.Iterator.iterable = accumulate
+	return accumulate
+}
+
+// Accumulate is an iterator that yields the accumulated values of another
+// iterator.
+//
+// This struct is not intended to be used directly, is created by the
+// Accumulate method.
+type Accumulate[T any] struct {
+	iter Iterable[T]
+	acc  *T
+	f    func(acc, value T) T
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the result of applying the function to
+// the accumulated value and the next value. The first value is returned as is.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3})
+//	sums := iter.Accumulate(func(acc, value int) int {
+//		return acc + value
+//	})
+//
+//	assert.Equal(t, []int{1, 3, 6}, sums.Collect())
+func (accumulate *Accumulate[T]) Next() *T {
+	next := accumulate.iter.Next()
+
+	if next == nil {
+		return nil
+	}
+
+	acc := *next
+	if accumulate.acc != nil {
+		acc = accumulate.f(*accumulate.acc, *next)
+	}
+
+	accumulate.acc = &acc
+	result := acc
+	return &result
+}
+
+// Returns a new iterator with the same values as the original, accumulating
+// again from the first value.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (accumulate *Accumulate[T]) Clone() *Accumulate[T] {
+	return NewAccumulate(clone(accumulate.iter), accumulate.f)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (accumulate *Accumulate[T]) cloneIter() Iterable[T] {
+	return accumulate.Clone()
+}
@@ -0,0 +1,100 @@
+//go:build go1.23
+
+package iters
+
+import "iter"
+
+// Returns an iter.Seq[T] that yields the values of the iterator, so it can be
+// used in a range loop.
+//
+// The values are consumed from the iterator while ranging, and the loop can
+// be stopped at any time with break.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4})
+//	evens := iter.Filter(func(v int) bool { return v%2 == 0 })
+//
+//	for v := range evens.Seq() {
+//		fmt.Println(v)
+//	}
+func (iter *Iterator[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := iter.Next(); v != nil; v = iter.Next() {
+			if !yield(*v) {
+				return
+			}
+		}
+	}
+}
+
+// NewPull returns a new iterator that pulls the values of an iter.Seq[T].
+//
+// This function is only intended to be used by the top level FromSeq and
+// FromSeq2 methods.
+func NewPull[T any](seq iter.Seq[T]) *Pull[T] {
+	next, stop := iter.Pull(seq)
+
+	pull := &Pull[T]{seq, next, stop, Iterator[T]{}}
+	pull.Iterator.iterable = pull
+	return pull
+}
+
+// Pull is an iterator that pulls the values of an iter.Seq[T], using
+// iter.Pull.
+//
+// The sequence runs in its own goroutine until it is exhausted, so if the
+// iterator is not consumed until the end, Stop must be called to release it.
+//
+// This struct is not intended to be used directly, is created by the top
+// level FromSeq and FromSeq2 methods.
+type Pull[T any] struct {
+	seq  iter.Seq[T]
+	next func() (T, bool)
+	stop func()
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value of the sequence.
+//
+// If there are no more values, the sequence is stopped and nil is returned.
+//
+// # Example
+//
+//	pull := itertools.FromSeq(slices.Values([]int{1, 2}))
+//
+//	assert.Equal(t, 1, *pull.Next())
+//	assert.Equal(t, 2, *pull.Next())
+//	assert.Nil(t, pull.Next())
+func (pull *Pull[T]) Next() *T {
+	value, ok := pull.next()
+
+	if !ok {
+		pull.stop()
+		return nil
+	}
+
+	return &value
+}
+
+// Stops the sequence before it is exhausted, releasing its resources.
+//
+// After calling Stop, Next always returns nil. It is safe to call Stop more
+// than once.
+func (pull *Pull[T]) Stop() {
+	pull.stop()
+}
+
+// Returns a new iterator that pulls the same sequence from the start.
+//
+// This is only independent of the original if the sequence can be ranged
+// more than once.
+func (pull *Pull[T]) Clone() *Pull[T] {
+	return NewPull(pull.seq)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (pull *Pull[T]) cloneIter() Iterable[T] {
+	return pull.Clone()
+}
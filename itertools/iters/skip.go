@@ -0,0 +1,124 @@
+package iters
+
+// NewSkip returns a new iterator that skips the first n values of another
+// iterator.
+//
+// This function is only intended to be used by the Skip method.
+func NewSkip[T any](iter Iterable[T], n uint) *Skip[T] {
+	skip := &Skip[T]{iter, n, false, Iterator[T]{}}
+	skip.Iterator.iterable = skip
+	return skip
+}
+
+// Skip is an iterator that skips the first n values of another iterator.
+//
+// This struct is not intended to be used directly, is created by the Skip method.
+type Skip[T any] struct {
+	iter    Iterable[T]
+	n       uint
+	skipped bool
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value. The first call skips the
+// first n values of the original iterator.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3})
+//	skip := iter.Skip(2)
+//
+//	assert.Equal(t, 3, *skip.Next())
+//	assert.Nil(t, skip.Next())
+func (skip *Skip[T]) Next() *T {
+	if !skip.skipped {
+		skip.skipped = true
+
+		for i := uint(0); i < skip.n; i++ {
+			if skip.iter.Next() == nil {
+				return nil
+			}
+		}
+	}
+
+	return skip.iter.Next()
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (skip *Skip[T]) Clone() *Skip[T] {
+	return NewSkip(clone(skip.iter), skip.n)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (skip *Skip[T]) cloneIter() Iterable[T] {
+	return skip.Clone()
+}
+
+// NewSkipWhile returns a new iterator that skips the values of another
+// iterator while they match a predicate.
+//
+// This function is only intended to be used by the SkipWhile method.
+func NewSkipWhile[T any](iter Iterable[T], predicate func(T) bool) *SkipWhile[T] {
+	skip := &SkipWhile[T]{iter, predicate, false, Iterator[T]{}}
+	skip.Iterator.iterable = skip
+	return skip
+}
+
+// SkipWhile is an iterator that skips the values of another iterator while
+// they match a predicate. Once a value does not match, the rest of the values
+// are yielded without checking the predicate.
+//
+// This struct is not intended to be used directly, is created by the
+// SkipWhile method.
+type SkipWhile[T any] struct {
+	iter      Iterable[T]
+	predicate func(T) bool
+	skipped   bool
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 1})
+//	skip := iter.SkipWhile(func(v int) bool { return v < 3 })
+//
+//	assert.Equal(t, 3, *skip.Next())
+//	assert.Equal(t, 1, *skip.Next())
+//	assert.Nil(t, skip.Next())
+func (skip *SkipWhile[T]) Next() *T {
+	if skip.skipped {
+		return skip.iter.Next()
+	}
+
+	skip.skipped = true
+
+	for v := skip.iter.Next(); v != nil; v = skip.iter.Next() {
+		if !skip.predicate(*v) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (skip *SkipWhile[T]) Clone() *SkipWhile[T] {
+	return NewSkipWhile(clone(skip.iter), skip.predicate)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (skip *SkipWhile[T]) cloneIter() Iterable[T] {
+	return skip.Clone()
+}
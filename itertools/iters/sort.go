@@ -0,0 +1,121 @@
+package iters
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/skylissh/std-go/cmp"
+)
+
+// Collect the values of the iterator into a slice, sorted in ascending order
+// using cmp.Is to compare the values. The sort is stable.
+//
+// The values must implement cmp.Comparable[T], or be one of the types
+// supported by cmp.Is, otherwise it panics.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{3, 1, 2})
+//
+//	assert.Equal(t, []int{1, 2, 3}, iter.Sorted())
+func (iter *Iterator[T]) Sorted() []T {
+	values := iter.Collect()
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return cmp.Is(values[i]).Less(values[j])
+	})
+
+	return values
+}
+
+// Collect the values of the iterator into a slice, sorted in ascending order
+// using the comparator to compare the values. The sort is stable, so values
+// that are equal for the comparator keep their order.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"ccc", "a", "bb"})
+//	sorted := iter.SortedBy(cmp.By(func(value, other string) int {
+//		return len(value) - len(other)
+//	}))
+//
+//	assert.Equal(t, []string{"a", "bb", "ccc"}, sorted)
+func (iter *Iterator[T]) SortedBy(comparator *cmp.Comparator[T]) []T {
+	values := iter.Collect()
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return comparator.Is(values[i]).Less(values[j])
+	})
+
+	return values
+}
+
+// Returns the k greatest values of the iterator, using the comparator to
+// compare the values, sorted in descending order.
+//
+// Only k values are kept in memory at any time, using a bounded heap, so it
+// can be used with iterators that are too big to be collected.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{5, 1, 4, 2, 3})
+//
+//	assert.Equal(t, []int{5, 4}, iter.TopK(2, cmp.By(func(value, other int) int {
+//		return value - other
+//	})))
+func (iter *Iterator[T]) TopK(k uint, comparator *cmp.Comparator[T]) []T {
+	// The heap grows as values arrive, so a big k doesn't allocate up front.
+	top := &topK[T]{make([]T, 0), comparator}
+
+	if k == 0 {
+		return top.values
+	}
+
+	iter.ForEach(func(value T) {
+		if uint(top.Len()) < k {
+			heap.Push(top, value)
+			return
+		}
+
+		if comparator.Is(value).Greater(top.values[0]) {
+			top.values[0] = value
+			heap.Fix(top, 0)
+		}
+	})
+
+	values := make([]T, top.Len())
+	for i := len(values) - 1; i >= 0; i-- {
+		values[i] = heap.Pop(top).(T)
+	}
+
+	return values
+}
+
+// A min-heap of values, ordered by a comparator, used to keep the k greatest
+// values seen by TopK.
+type topK[T any] struct {
+	values     []T
+	comparator *cmp.Comparator[T]
+}
+
+func (h *topK[T]) Len() int {
+	return len(h.values)
+}
+
+func (h *topK[T]) Less(i, j int) bool {
+	return h.comparator.Is(h.values[i]).Less(h.values[j])
+}
+
+func (h *topK[T]) Swap(i, j int) {
+	h.values[i], h.values[j] = h.values[j], h.values[i]
+}
+
+func (h *topK[T]) Push(value any) {
+	h.values = append(h.values, value.(T))
+}
+
+func (h *topK[T]) Pop() any {
+	last := h.values[len(h.values)-1]
+	h.values = h.values[:len(h.values)-1]
+	return last
+}
@@ -0,0 +1,47 @@
+package iters_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/cmp"
+	"github.com/skylissh/std-go/itertools/iters"
+	"github.com/stretchr/testify/assert"
+)
+
+var byValue = cmp.By(func(value, other int) int {
+	return value - other
+})
+
+func TestSorted(t *testing.T) {
+	iter := iters.NewIter(&[]int{3, 1, 2})
+
+	assert.Equal(t, []int{1, 2, 3}, iter.Sorted())
+}
+
+func TestSortedByStable(t *testing.T) {
+	iter := iters.NewIter(&[]string{"bb", "a", "cc", "d"})
+
+	assert.Equal(t, []string{"a", "d", "bb", "cc"}, iter.SortedBy(byLength))
+}
+
+func TestTopK(t *testing.T) {
+	iter := _iter.Clone()
+
+	assert.Equal(t, []int{10, 9, 8}, iter.TopK(3, byValue))
+}
+
+func TestTopKMoreThanAvailable(t *testing.T) {
+	iter := iters.NewIter(&[]int{2, 3, 1})
+
+	assert.Equal(t, []int{3, 2, 1}, iter.TopK(5, byValue))
+}
+
+func TestTopKZero(t *testing.T) {
+	assert.Empty(t, _iter.Clone().TopK(0, byValue))
+}
+
+func TestTopKHuge(t *testing.T) {
+	iter := iters.NewIter(&[]int{2, 3, 1})
+
+	assert.Equal(t, []int{3, 2, 1}, iter.TopK(^uint(0), byValue))
+}
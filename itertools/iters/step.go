@@ -0,0 +1,143 @@
+package iters
+
+// NewStepBy returns a new iterator that yields the first value of another
+// iterator, and then every step-th value.
+//
+// The step must be greater than 0, otherwise it panics.
+//
+// This function is only intended to be used by the StepBy method.
+func NewStepBy[T any](iter Iterable[T], step uint) *StepBy[T] {
+	if step == 0 {
+		panic("The step must be greater than 0")
+	}
+
+	s := &StepBy[T]{iter, step, true, Iterator[T]{}}
+	s.Iterator.iterable = s
+	return s
+}
+
+// StepBy is an iterator that yields the first value of another iterator, and
+// then every step-th value.
+//
+// This struct is not intended to be used directly, is created by the StepBy
+// method.
+type StepBy[T any] struct {
+	iter  Iterable[T]
+	step  uint
+	first bool
+
+	Iterator[T]
+}
+
+// Advances the iterator step times and returns the value reached.
+//
+// If there are no more values, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 4, 5})
+//	step := iter.StepBy(2)
+//
+//	assert.Equal(t, 1, *step.Next())
+//	assert.Equal(t, 3, *step.Next())
+//	assert.Equal(t, 5, *step.Next())
+//	assert.Nil(t, step.Next())
+func (s *StepBy[T]) Next() *T {
+	if s.first {
+		s.first = false
+		return s.iter.Next()
+	}
+
+	for i := uint(1); i < s.step; i++ {
+		if s.iter.Next() == nil {
+			return nil
+		}
+	}
+
+	return s.iter.Next()
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (s *StepBy[T]) Clone() *StepBy[T] {
+	return NewStepBy(clone(s.iter), s.step)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (s *StepBy[T]) cloneIter() Iterable[T] {
+	return s.Clone()
+}
+
+// NewSlice returns a new iterator that yields the values of another iterator
+// from the index start, up to but not including the index stop, advancing
+// step values each time.
+//
+// The step must be greater than 0, otherwise it panics.
+//
+// This function is only intended to be used by the Slice method.
+func NewSlice[T any](iter Iterable[T], start, stop, step uint) *Slice[T] {
+	if step == 0 {
+		panic("The step must be greater than 0")
+	}
+
+	slice := &Slice[T]{iter, start, stop, step, 0, Iterator[T]{}}
+	slice.Iterator.iterable = slice
+	return slice
+}
+
+// Slice is an iterator that yields the values of another iterator between
+// two indexes, advancing step values each time.
+//
+// This struct is not intended to be used directly, is created by the Slice
+// method.
+type Slice[T any] struct {
+	iter  Iterable[T]
+	start uint
+	stop  uint
+	step  uint
+	index uint
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value inside the slice.
+//
+// If there are no more values, or the stop index is reached, nil is returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{0, 1, 2, 3, 4, 5, 6})
+//	slice := iter.Slice(1, 6, 2)
+//
+//	assert.Equal(t, []int{1, 3, 5}, slice.Collect())
+func (slice *Slice[T]) Next() *T {
+	for slice.index < slice.stop {
+		v := slice.iter.Next()
+		if v == nil {
+			slice.index = slice.stop
+			return nil
+		}
+
+		index := slice.index
+		slice.index++
+
+		if index >= slice.start && (index-slice.start)%slice.step == 0 {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (slice *Slice[T]) Clone() *Slice[T] {
+	return NewSlice(clone(slice.iter), slice.start, slice.stop, slice.step)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (slice *Slice[T]) cloneIter() Iterable[T] {
+	return slice.Clone()
+}
@@ -4,7 +4,7 @@ package iters
 //
 // This function is only intended to be used by the Take method.
 func NewTake[T any](iter Iterable[T], n uint) *Take[T] {
-	take := &Take[T]{iter, n, Iterator[T]{}}
+	take := &Take[T]{iter, n, n, Iterator[T]{}}
 	take.Iterator.iterable = take
 	return take
 }
@@ -13,8 +13,9 @@ func NewTake[T any](iter Iterable[T], n uint) *Take[T] {
 //
 // This struct is not intended to be used directly, is created by the Take method.
 type Take[T any] struct {
-	iter Iterable[T]
-	n    uint
+	iter  Iterable[T]
+	n     uint
+	limit uint
 
 	Iterator[T]
 }
@@ -63,5 +64,73 @@ func (take *Take[T]) Next() *T {
 //	assert.Equal(t, 3, *iter.Next())
 //	assert.Nil(t, iter.Next())
 func (take *Take[T]) Clone() Iterable[T] {
-	return NewTake(take.iter, take.n)
+	return NewTake(clone(take.iter), take.limit)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (take *Take[T]) cloneIter() Iterable[T] {
+	return take.Clone()
+}
+
+// NewTakeWhile returns a new iterator that yields the values of another
+// iterator while they match a predicate.
+//
+// This function is only intended to be used by the TakeWhile method.
+func NewTakeWhile[T any](iter Iterable[T], predicate func(T) bool) *TakeWhile[T] {
+	take := &TakeWhile[T]{iter, predicate, false, Iterator[T]{}}
+	take.Iterator.iterable = take
+	return take
+}
+
+// TakeWhile is an iterator that yields the values of another iterator while
+// they match a predicate.
+//
+// This struct is not intended to be used directly, is created by the
+// TakeWhile method.
+type TakeWhile[T any] struct {
+	iter      Iterable[T]
+	predicate func(T) bool
+	done      bool
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value, if it matches the
+// predicate.
+//
+// Once a value does not match the predicate, the iterator is exhausted and
+// always returns nil. Note that the value that does not match is consumed
+// from the original iterator.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3, 1})
+//	take := iter.TakeWhile(func(v int) bool { return v < 3 })
+//
+//	assert.Equal(t, 1, *take.Next())
+//	assert.Equal(t, 2, *take.Next())
+//	assert.Nil(t, take.Next())
+func (take *TakeWhile[T]) Next() *T {
+	if take.done {
+		return nil
+	}
+
+	if v := take.iter.Next(); v != nil && take.predicate(*v) {
+		return v
+	}
+
+	take.done = true
+	return nil
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The original iterator is also cloned, so it panics if it is not cloneable.
+func (take *TakeWhile[T]) Clone() *TakeWhile[T] {
+	return NewTakeWhile(clone(take.iter), take.predicate)
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (take *TakeWhile[T]) cloneIter() Iterable[T] {
+	return take.Clone()
 }
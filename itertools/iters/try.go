@@ -0,0 +1,284 @@
+package iters
+
+// TryIterator is the base struct for all fallible iterators.
+//
+// You can use it to create your own fallible iterators, only remember to
+// implement the Next method. All the methods stop at the first error.
+type TryIterator[T any] struct {
+	iterable TryIterable[T]
+}
+
+// Next returns the next value of the iterator, and advances the iterator.
+//
+// If the iterator is empty, it returns nil and a nil error.
+func (iter *TryIterator[T]) Next() (*T, error) {
+	return iter.iterable.Next()
+}
+
+// Iterates over the iterator and calls the function f for each value, until
+// the iterator is exhausted or an error happens.
+//
+// Returns the first error, either from the iterator or from f.
+//
+// # Example
+//
+//	err := rows.TryForEach(func(row Row) error {
+//		return db.Insert(row)
+//	})
+func (iter *TryIterator[T]) TryForEach(f func(T) error) error {
+	for {
+		v, err := iter.Next()
+		if err != nil {
+			return err
+		}
+
+		if v == nil {
+			return nil
+		}
+
+		if err := f(*v); err != nil {
+			return err
+		}
+	}
+}
+
+// Collect the values of the iterator into a slice, stopping at the first
+// error.
+//
+// If an error happens, the values collected so far are returned with it.
+//
+// # Example
+//
+//	iter := itertools.Try[string](itertools.AsIter([]string{"1", "x", "3"}))
+//	numbers, err := itertools.TryMap[string](iter, strconv.Atoi).TryCollect()
+//
+//	assert.Equal(t, []int{1}, numbers)
+//	assert.Error(t, err)
+func (iter *TryIterator[T]) TryCollect() ([]T, error) {
+	collect := make([]T, 0)
+
+	err := iter.TryForEach(func(value T) error {
+		collect = append(collect, value)
+		return nil
+	})
+
+	return collect, err
+}
+
+// Returns a new fallible iterator with the values that match the predicate.
+// If the predicate returns an error, the iterator stops with it.
+//
+// # Example
+//
+//	valid := rows.TryFilter(func(row Row) (bool, error) {
+//		return row.Validate()
+//	})
+func (iter *TryIterator[T]) TryFilter(predicate func(T) (bool, error)) *TryFilter[T] {
+	return NewTryFilter(iter.iterable, predicate)
+}
+
+// Returns an iterator with the values of the fallible iterator, that stops
+// at the first error. The error is available with the Err method.
+//
+// # Example
+//
+//	values := rows.Unwrap()
+//	values.ForEach(process)
+//
+//	if err := values.Err(); err != nil {
+//		return err
+//	}
+func (iter *TryIterator[T]) Unwrap() *Unwrap[T] {
+	return NewUnwrap(iter.iterable)
+}
+
+// NewTry returns a new fallible iterator with the values of another iterator.
+//
+// If the iterator is Fallible, its error is returned once it is exhausted.
+// Otherwise the fallible iterator never fails.
+//
+// This function is only intended to be used by the top level Try method.
+func NewTry[T any](iter Iterable[T]) *Try[T] {
+	try := &Try[T]{iter, TryIterator[T]{}}
+	try.TryIterator.iterable = try
+	return try
+}
+
+// Try is a fallible iterator with the values of another iterator.
+//
+// This struct is not intended to be used directly, is created by the top
+// level Try method.
+type Try[T any] struct {
+	iter Iterable[T]
+
+	TryIterator[T]
+}
+
+// Advances the iterator and returns the next value.
+//
+// If there are no more values, nil is returned with the error of the
+// original iterator, if it is Fallible.
+func (try *Try[T]) Next() (*T, error) {
+	if v := try.iter.Next(); v != nil {
+		return v, nil
+	}
+
+	if fallible, ok := try.iter.(Fallible[T]); ok {
+		return nil, fallible.Err()
+	}
+
+	return nil, nil
+}
+
+// NewTryMap returns a new fallible iterator that maps the values of another
+// fallible iterator, using a function that can fail.
+//
+// This function is only intended to be used by the top level TryMap method.
+func NewTryMap[T, E any](iter TryIterable[T], f func(T) (E, error)) *TryMap[T, E] {
+	m := &TryMap[T, E]{iter, f, nil, TryIterator[E]{}}
+	m.TryIterator.iterable = m
+	return m
+}
+
+// TryMap is a fallible iterator that maps the values of another fallible
+// iterator.
+//
+// This struct is not intended to be used directly, is created by the top
+// level TryMap method.
+type TryMap[T, E any] struct {
+	iter TryIterable[T]
+	f    func(T) (E, error)
+	err  error
+
+	TryIterator[E]
+}
+
+// Advances the iterator and returns the next value, mapped by the function.
+//
+// Once the original iterator or the function fails, the error is returned by
+// this and every following call.
+func (m *TryMap[T, E]) Next() (*E, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	next, err := m.iter.Next()
+	if err != nil {
+		m.err = err
+		return nil, err
+	}
+
+	if next == nil {
+		return nil, nil
+	}
+
+	result, err := m.f(*next)
+	if err != nil {
+		m.err = err
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// NewTryFilter returns a new fallible iterator that filters the values of
+// another fallible iterator, using a predicate that can fail.
+//
+// This function is only intended to be used by the TryFilter method.
+func NewTryFilter[T any](iter TryIterable[T], predicate func(T) (bool, error)) *TryFilter[T] {
+	filter := &TryFilter[T]{iter, predicate, nil, TryIterator[T]{}}
+	filter.TryIterator.iterable = filter
+	return filter
+}
+
+// TryFilter is a fallible iterator that filters the values of another
+// fallible iterator.
+//
+// This struct is not intended to be used directly, is created by the
+// TryFilter method.
+type TryFilter[T any] struct {
+	iter      TryIterable[T]
+	predicate func(T) (bool, error)
+	err       error
+
+	TryIterator[T]
+}
+
+// Advances the iterator and returns the next value that matches the
+// predicate.
+//
+// Once the original iterator or the predicate fails, the error is returned
+// by this and every following call.
+func (filter *TryFilter[T]) Next() (*T, error) {
+	if filter.err != nil {
+		return nil, filter.err
+	}
+
+	for {
+		v, err := filter.iter.Next()
+		if err != nil {
+			filter.err = err
+			return nil, err
+		}
+
+		if v == nil {
+			return nil, nil
+		}
+
+		ok, err := filter.predicate(*v)
+		if err != nil {
+			filter.err = err
+			return nil, err
+		}
+
+		if ok {
+			return v, nil
+		}
+	}
+}
+
+// NewUnwrap returns a new iterator with the values of a fallible iterator,
+// that stops at the first error.
+//
+// This function is only intended to be used by the Unwrap method.
+func NewUnwrap[T any](iter TryIterable[T]) *Unwrap[T] {
+	unwrap := &Unwrap[T]{iter, nil, Iterator[T]{}}
+	unwrap.Iterator.iterable = unwrap
+	return unwrap
+}
+
+// Unwrap is an iterator with the values of a fallible iterator. It implements
+// Fallible, so the error that stopped it can be retrieved with Err.
+//
+// This struct is not intended to be used directly, is created by the Unwrap
+// method.
+type Unwrap[T any] struct {
+	iter TryIterable[T]
+	err  error
+
+	Iterator[T]
+}
+
+// Advances the iterator and returns the next value.
+//
+// If there are no more values, or the fallible iterator failed, nil is
+// returned. Use Err to tell apart both cases.
+func (unwrap *Unwrap[T]) Next() *T {
+	if unwrap.err != nil {
+		return nil
+	}
+
+	v, err := unwrap.iter.Next()
+	if err != nil {
+		unwrap.err = err
+		return nil
+	}
+
+	return v
+}
+
+// Returns the error that stopped the iterator, or nil if it was exhausted
+// without errors.
+func (unwrap *Unwrap[T]) Err() error {
+	return unwrap.err
+}
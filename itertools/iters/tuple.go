@@ -0,0 +1,51 @@
+package iters
+
+// Pair is a generic tuple of two values.
+//
+// It is the value yielded by iterators that combine two iterators, like Zip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair returns a new Pair with the given values.
+func NewPair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{first, second}
+}
+
+// Returns the values of the pair, so it can be used in multiple assignments.
+//
+// # Example
+//
+//	pair := iters.NewPair(1, "one")
+//	number, name := pair.Unpack()
+//
+//	assert.Equal(t, 1, number)
+//	assert.Equal(t, "one", name)
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// Triple is a generic tuple of three values.
+//
+// It is the value yielded by iterators that combine three iterators, like Zip3.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple returns a new Triple with the given values.
+func NewTriple[A, B, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{first, second, third}
+}
+
+// Returns the values of the triple, so it can be used in multiple assignments.
+//
+// # Example
+//
+//	triple := iters.NewTriple(1, "one", true)
+//	number, name, ok := triple.Unpack()
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
@@ -0,0 +1,198 @@
+package iters
+
+// NewZip returns a new iterator that yields pairs with the values of two
+// iterators, advancing both at the same time.
+//
+// This function is only intended to be used by the top level Zip method.
+func NewZip[A, B any](a Iterable[A], b Iterable[B]) *Zip[A, B] {
+	zip := &Zip[A, B]{a, b, Iterator[Pair[A, B]]{}}
+	zip.Iterator.iterable = zip
+	return zip
+}
+
+// Zip is an iterator that yields pairs with the values of two iterators.
+//
+// This struct is not intended to be used directly, is created by
+// the top level Zip method.
+type Zip[A, B any] struct {
+	a Iterable[A]
+	b Iterable[B]
+
+	Iterator[Pair[A, B]]
+}
+
+// Advances both iterators and returns a pair with their values.
+//
+// If any of the iterators is exhausted, nil is returned.
+//
+// # Example
+//
+//	numbers := itertools.AsIter([]int{1, 2, 3})
+//	names := itertools.AsIter([]string{"one", "two"})
+//	zip := itertools.Zip(numbers, names)
+//
+//	assert.Equal(t, iters.NewPair(1, "one"), *zip.Next())
+//	assert.Equal(t, iters.NewPair(2, "two"), *zip.Next())
+//	assert.Nil(t, zip.Next())
+func (zip *Zip[A, B]) Next() *Pair[A, B] {
+	a := zip.a.Next()
+	if a == nil {
+		return nil
+	}
+
+	b := zip.b.Next()
+	if b == nil {
+		return nil
+	}
+
+	return &Pair[A, B]{*a, *b}
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The zipped iterators are also cloned, so it panics if any of them is not
+// cloneable.
+func (zip *Zip[A, B]) Clone() *Zip[A, B] {
+	return NewZip(clone(zip.a), clone(zip.b))
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (zip *Zip[A, B]) cloneIter() Iterable[Pair[A, B]] {
+	return zip.Clone()
+}
+
+// NewZipLongest returns a new iterator that yields pairs with the values of
+// two iterators, until both of them are exhausted.
+//
+// This function is only intended to be used by the top level ZipLongest method.
+func NewZipLongest[A, B any](a Iterable[A], b Iterable[B]) *ZipLongest[A, B] {
+	zip := &ZipLongest[A, B]{a, b, Iterator[Pair[*A, *B]]{}}
+	zip.Iterator.iterable = zip
+	return zip
+}
+
+// ZipLongest is an iterator that yields pairs with the values of two
+// iterators, until both of them are exhausted.
+//
+// The values of the pairs are pointers, which are nil once the corresponding
+// iterator is exhausted.
+//
+// This struct is not intended to be used directly, is created by
+// the top level ZipLongest method.
+type ZipLongest[A, B any] struct {
+	a Iterable[A]
+	b Iterable[B]
+
+	Iterator[Pair[*A, *B]]
+}
+
+// Advances both iterators and returns a pair with their values.
+//
+// If only one of the iterators is exhausted, its value in the pair is nil.
+// If both are exhausted, nil is returned.
+//
+// # Example
+//
+//	numbers := itertools.AsIter([]int{1, 2})
+//	names := itertools.AsIter([]string{"one"})
+//	zip := itertools.ZipLongest(numbers, names)
+//
+//	pair := zip.Next()
+//	assert.Equal(t, 1, *pair.First)
+//	assert.Equal(t, "one", *pair.Second)
+//
+//	pair = zip.Next()
+//	assert.Equal(t, 2, *pair.First)
+//	assert.Nil(t, pair.Second)
+//
+//	assert.Nil(t, zip.Next())
+func (zip *ZipLongest[A, B]) Next() *Pair[*A, *B] {
+	a, b := zip.a.Next(), zip.b.Next()
+
+	if a == nil && b == nil {
+		return nil
+	}
+
+	return &Pair[*A, *B]{a, b}
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The zipped iterators are also cloned, so it panics if any of them is not
+// cloneable.
+func (zip *ZipLongest[A, B]) Clone() *ZipLongest[A, B] {
+	return NewZipLongest(clone(zip.a), clone(zip.b))
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (zip *ZipLongest[A, B]) cloneIter() Iterable[Pair[*A, *B]] {
+	return zip.Clone()
+}
+
+// NewZip3 returns a new iterator that yields triples with the values of three
+// iterators, advancing all of them at the same time.
+//
+// This function is only intended to be used by the top level Zip3 method.
+func NewZip3[A, B, C any](a Iterable[A], b Iterable[B], c Iterable[C]) *Zip3[A, B, C] {
+	zip := &Zip3[A, B, C]{a, b, c, Iterator[Triple[A, B, C]]{}}
+	zip.Iterator.iterable = zip
+	return zip
+}
+
+// Zip3 is an iterator that yields triples with the values of three iterators.
+//
+// This struct is not intended to be used directly, is created by
+// the top level Zip3 method.
+type Zip3[A, B, C any] struct {
+	a Iterable[A]
+	b Iterable[B]
+	c Iterable[C]
+
+	Iterator[Triple[A, B, C]]
+}
+
+// Advances the three iterators and returns a triple with their values.
+//
+// If any of the iterators is exhausted, nil is returned.
+//
+// # Example
+//
+//	numbers := itertools.AsIter([]int{1, 2})
+//	names := itertools.AsIter([]string{"one", "two"})
+//	evens := itertools.AsIter([]bool{false, true})
+//	zip := itertools.Zip3(numbers, names, evens)
+//
+//	assert.Equal(t, iters.NewTriple(1, "one", false), *zip.Next())
+//	assert.Equal(t, iters.NewTriple(2, "two", true), *zip.Next())
+//	assert.Nil(t, zip.Next())
+func (zip *Zip3[A, B, C]) Next() *Triple[A, B, C] {
+	a := zip.a.Next()
+	if a == nil {
+		return nil
+	}
+
+	b := zip.b.Next()
+	if b == nil {
+		return nil
+	}
+
+	c := zip.c.Next()
+	if c == nil {
+		return nil
+	}
+
+	return &Triple[A, B, C]{*a, *b, *c}
+}
+
+// Returns a new iterator with the same values as the original.
+//
+// The zipped iterators are also cloned, so it panics if any of them is not
+// cloneable.
+func (zip *Zip3[A, B, C]) Clone() *Zip3[A, B, C] {
+	return NewZip3(clone(zip.a), clone(zip.b), clone(zip.c))
+}
+
+// Implements cloner, so the adapters wrapping the iterator can clone it.
+func (zip *Zip3[A, B, C]) cloneIter() Iterable[Triple[A, B, C]] {
+	return zip.Clone()
+}
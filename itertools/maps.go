@@ -0,0 +1,121 @@
+package itertools
+
+import (
+	"sort"
+
+	"github.com/skylissh/std-go/cmp"
+	"github.com/skylissh/std-go/itertools/iters"
+	"golang.org/x/exp/constraints"
+)
+
+// Returns an iterator over the keys of the map, in no particular order.
+//
+// The keys are copied when the iterator is created, so later changes to the
+// map are not reflected.
+//
+// # Example
+//
+//	keys := itertools.Keys(map[string]int{"a": 1, "b": 2})
+//
+//	assert.ElementsMatch(t, []string{"a", "b"}, keys.Collect())
+func Keys[K comparable, V any](m map[K]V) *iters.Iter[K] {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	return AsIter(keys)
+}
+
+// Returns an iterator over the values of the map, in no particular order.
+//
+// The values are copied when the iterator is created, so later changes to the
+// map are not reflected.
+//
+// # Example
+//
+//	values := itertools.Values(map[string]int{"a": 1, "b": 2})
+//
+//	assert.ElementsMatch(t, []int{1, 2}, values.Collect())
+func Values[K comparable, V any](m map[K]V) *iters.Iter[V] {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+
+	return AsIter(values)
+}
+
+// Returns an iterator over the key/value pairs of the map, in no particular
+// order.
+//
+// The pairs are copied when the iterator is created, so later changes to the
+// map are not reflected.
+//
+// # Example
+//
+//	entries := itertools.Entries(map[string]int{"a": 1})
+//
+//	assert.Equal(t, iters.NewPair("a", 1), *entries.Next())
+func Entries[K comparable, V any](m map[K]V) *iters.Iter[iters.Pair[K, V]] {
+	return AsIter(entries(m))
+}
+
+// Returns an iterator over the keys of the map, in ascending order.
+//
+// # Example
+//
+//	keys := itertools.SortedKeys(map[string]int{"b": 2, "a": 1, "c": 3})
+//
+//	assert.Equal(t, []string{"a", "b", "c"}, keys.Collect())
+func SortedKeys[K constraints.Ordered, V any](m map[K]V) *iters.Iter[K] {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	return AsIter(keys)
+}
+
+// Returns an iterator over the key/value pairs of the map, in ascending order
+// of the keys, using the comparator to compare them.
+//
+// The comparator must be a strict total order, that only returns 0 for equal
+// keys. Otherwise the keys it considers equal are yielded in a random order,
+// as the map has no order to preserve. Pass a tie breaker as the last
+// function of the comparator to make the order deterministic, like
+// cmp.By(insensitive, strings.Compare). Panics if the comparator is nil.
+//
+// # Example
+//
+//	entries := itertools.SortedEntries(map[string]int{"b": 2, "a": 1}, cmp.By(strings.Compare))
+//
+//	assert.Equal(t, iters.NewPair("a", 1), *entries.Next())
+//	assert.Equal(t, iters.NewPair("b", 2), *entries.Next())
+func SortedEntries[K comparable, V any](m map[K]V, comparator *cmp.Comparator[K]) *iters.Iter[iters.Pair[K, V]] {
+	if comparator == nil {
+		panic("The comparator must not be nil")
+	}
+
+	pairs := entries(m)
+
+	sort.Slice(pairs, func(i, j int) bool {
+		return comparator.Is(pairs[i].First).Less(pairs[j].First)
+	})
+
+	return AsIter(pairs)
+}
+
+// Returns the key/value pairs of the map in a slice.
+func entries[K comparable, V any](m map[K]V) []iters.Pair[K, V] {
+	pairs := make([]iters.Pair[K, V], 0, len(m))
+	for k, v := range m {
+		pairs = append(pairs, iters.NewPair(k, v))
+	}
+
+	return pairs
+}
@@ -0,0 +1,78 @@
+package itertools_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/skylissh/std-go/cmp"
+	"github.com/skylissh/std-go/itertools"
+	"github.com/skylissh/std-go/itertools/iters"
+	"github.com/stretchr/testify/assert"
+)
+
+var scores = map[string]int{"bob": 2, "alice": 1, "Carol": 3}
+
+func TestKeys(t *testing.T) {
+	assert.ElementsMatch(t, []string{"alice", "bob", "Carol"}, itertools.Keys(scores).Collect())
+}
+
+func TestValues(t *testing.T) {
+	assert.ElementsMatch(t, []int{1, 2, 3}, itertools.Values(scores).Collect())
+}
+
+func TestEntries(t *testing.T) {
+	expect := []iters.Pair[string, int]{iters.NewPair("alice", 1), iters.NewPair("bob", 2), iters.NewPair("Carol", 3)}
+
+	assert.ElementsMatch(t, expect, itertools.Entries(scores).Collect())
+}
+
+func TestSortedKeys(t *testing.T) {
+	assert.Equal(t, []string{"Carol", "alice", "bob"}, itertools.SortedKeys(scores).Collect())
+}
+
+func TestSortedEntries(t *testing.T) {
+	expect := []iters.Pair[string, int]{iters.NewPair("Carol", 3), iters.NewPair("alice", 1), iters.NewPair("bob", 2)}
+
+	assert.Equal(t, expect, itertools.SortedEntries(scores, cmp.By(strings.Compare)).Collect())
+}
+
+func TestSortedEntriesInt64(t *testing.T) {
+	byValue := cmp.By(func(value, other int64) int {
+		return int(value - other)
+	})
+	expect := []iters.Pair[int64, string]{iters.NewPair[int64](1, "a"), iters.NewPair[int64](2, "b")}
+
+	assert.Equal(t, expect, itertools.SortedEntries(map[int64]string{2: "b", 1: "a"}, byValue).Collect())
+}
+
+func TestSortedEntriesNil(t *testing.T) {
+	assert.PanicsWithValue(t, "The comparator must not be nil", func() {
+		itertools.SortedEntries[string, int](scores, nil)
+	})
+}
+
+func TestSortedEntriesComparator(t *testing.T) {
+	insensitive := cmp.By(func(value, other string) int {
+		return strings.Compare(strings.ToLower(value), strings.ToLower(other))
+	})
+	expect := []iters.Pair[string, int]{iters.NewPair("alice", 1), iters.NewPair("bob", 2), iters.NewPair("Carol", 3)}
+
+	assert.Equal(t, expect, itertools.SortedEntries(scores, insensitive).Collect())
+}
+
+func TestSortedEntriesTieBreaker(t *testing.T) {
+	letters := map[string]int{"a": 1, "A": 2, "b": 3, "B": 4, "c": 5, "C": 6}
+	insensitive := cmp.By(func(value, other string) int {
+		return strings.Compare(strings.ToLower(value), strings.ToLower(other))
+	}, strings.Compare)
+	expect := []string{"A", "a", "B", "b", "C", "c"}
+
+	for i := 0; i < 50; i++ {
+		entries := itertools.SortedEntries(letters, insensitive)
+		keys := itertools.Map[iters.Pair[string, int]](entries, func(p iters.Pair[string, int]) string {
+			return p.First
+		})
+
+		assert.Equal(t, expect, keys.Collect())
+	}
+}
@@ -0,0 +1,39 @@
+package itertools
+
+import (
+	"github.com/skylissh/std-go/cmp"
+	"github.com/skylissh/std-go/itertools/iters"
+)
+
+// Returns an iterator that lazily merges already sorted iterators into a
+// single sorted iterator, using a heap with the next value of each iterator.
+//
+// If comparator is nil, the values are compared with cmp.Is, so they must
+// implement cmp.Comparable[T] or be one of the types supported by it, like
+// time.Time.
+//
+// # Example
+//
+//	a := itertools.AsIter([]time.Time{t1, t3})
+//	b := itertools.AsIter([]time.Time{t2})
+//
+//	assert.Equal(t, []time.Time{t1, t2, t3}, itertools.MergeSorted[time.Time](nil, a, b).Collect())
+func MergeSorted[T any](comparator *cmp.Comparator[T], iterables ...iters.Iterable[T]) *iters.Merge[T] {
+	return iters.NewMerge(comparator, false, iterables...)
+}
+
+// Returns an iterator that lazily merges already sorted iterators into a
+// single sorted iterator, skipping the values that are equal to the previous
+// one.
+//
+// If comparator is nil, the values are compared with cmp.Is.
+//
+// # Example
+//
+//	a := itertools.AsIter([]int{1, 2, 3})
+//	b := itertools.AsIter([]int{2, 3, 4})
+//
+//	assert.Equal(t, []int{1, 2, 3, 4}, itertools.MergeSortedDedup[int](nil, a, b).Collect())
+func MergeSortedDedup[T any](comparator *cmp.Comparator[T], iterables ...iters.Iterable[T]) *iters.Merge[T] {
+	return iters.NewMerge(comparator, true, iterables...)
+}
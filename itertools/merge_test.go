@@ -0,0 +1,56 @@
+package itertools_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skylissh/std-go/cmp"
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeSorted(t *testing.T) {
+	a := itertools.AsIter([]int{1, 4, 7})
+	b := itertools.AsIter([]int{2, 5})
+	c := itertools.AsIter([]int{3, 6, 8, 9})
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, itertools.MergeSorted[int](nil, a, b, c).Collect())
+}
+
+func TestMergeSortedTime(t *testing.T) {
+	now := time.Now()
+	a := itertools.AsIter([]time.Time{now, now.Add(2 * time.Second)})
+	b := itertools.AsIter([]time.Time{now.Add(time.Second)})
+	expect := []time.Time{now, now.Add(time.Second), now.Add(2 * time.Second)}
+
+	assert.Equal(t, expect, itertools.MergeSorted[time.Time](nil, a, b).Collect())
+}
+
+func TestMergeSortedComparator(t *testing.T) {
+	descending := cmp.By(func(value, other int) int { return other - value })
+	a := itertools.AsIter([]int{5, 3, 1})
+	b := itertools.AsIter([]int{4, 2})
+
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, itertools.MergeSorted[int](descending, a, b).Collect())
+}
+
+func TestMergeSortedStable(t *testing.T) {
+	byAge := cmp.By(func(value, other person) int { return value.age - other.age })
+	a := itertools.AsIter([]person{{"Alice", 20}, {"Carol", 30}})
+	b := itertools.AsIter([]person{{"Bob", 20}})
+	expect := []person{{"Alice", 20}, {"Bob", 20}, {"Carol", 30}}
+
+	assert.Equal(t, expect, itertools.MergeSorted[person](byAge, a, b).Collect())
+}
+
+func TestMergeSortedDedup(t *testing.T) {
+	a := itertools.AsIter([]int{1, 2, 2, 3})
+	b := itertools.AsIter([]int{2, 3, 4})
+
+	assert.Equal(t, []int{1, 2, 3, 4}, itertools.MergeSortedDedup[int](nil, a, b).Collect())
+}
+
+func TestMergeSortedEmpty(t *testing.T) {
+	assert.Empty(t, itertools.MergeSorted[int](nil, itertools.AsIter([]int{})).Collect())
+	assert.Empty(t, itertools.MergeSorted[int](nil).Collect())
+}
@@ -0,0 +1,65 @@
+package itertools
+
+import (
+	"github.com/skylissh/std-go/itertools/iters"
+	"golang.org/x/exp/constraints"
+)
+
+// Returns the value of the iterator with the minimum key. If several values
+// have the minimum key, the first one is returned.
+//
+// Returns false if the iterator is empty.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"ccc", "a", "bb"})
+//	shortest, _ := itertools.MinByKey[string](iter, func(v string) int {
+//		return len(v)
+//	})
+//
+//	assert.Equal(t, "a", shortest)
+func MinByKey[T any, K constraints.Ordered](iter iters.Iterable[T], key func(value T) K) (T, bool) {
+	return byKey(iter, key, func(value, best K) bool {
+		return value < best
+	})
+}
+
+// Returns the value of the iterator with the maximum key. If several values
+// have the maximum key, the first one is returned.
+//
+// Returns false if the iterator is empty.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]string{"a", "ccc", "bb"})
+//	longest, _ := itertools.MaxByKey[string](iter, func(v string) int {
+//		return len(v)
+//	})
+//
+//	assert.Equal(t, "ccc", longest)
+func MaxByKey[T any, K constraints.Ordered](iter iters.Iterable[T], key func(value T) K) (T, bool) {
+	return byKey(iter, key, func(value, best K) bool {
+		return value > best
+	})
+}
+
+// Returns the first value whose key is better than the keys of the values
+// before it. The key of each value is only computed once.
+func byKey[T any, K constraints.Ordered](iter iters.Iterable[T], key func(value T) K, better func(value, best K) bool) (T, bool) {
+	first := iter.Next()
+
+	if first == nil {
+		var zero T
+		return zero, false
+	}
+
+	best, bestKey := *first, key(*first)
+
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		if k := key(*v); better(k, bestKey) {
+			best, bestKey = *v, k
+		}
+	}
+
+	return best, true
+}
@@ -0,0 +1,41 @@
+package itertools_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func length(value string) int {
+	return len(value)
+}
+
+func TestMinByKey(t *testing.T) {
+	shortest, ok := itertools.MinByKey[string](itertools.AsIter([]string{"ccc", "a", "bb"}), length)
+
+	assert.True(t, ok)
+	assert.Equal(t, "a", shortest)
+}
+
+func TestMaxByKey(t *testing.T) {
+	longest, ok := itertools.MaxByKey[string](itertools.AsIter([]string{"a", "ccc", "bb"}), length)
+
+	assert.True(t, ok)
+	assert.Equal(t, "ccc", longest)
+}
+
+func TestMaxByKeyEmpty(t *testing.T) {
+	_, ok := itertools.MaxByKey[string](itertools.AsIter([]string{}), length)
+
+	assert.False(t, ok)
+}
+
+func TestMinByKeyInt64(t *testing.T) {
+	iter := itertools.AsIter([]string{"ccc", "a", "bb"})
+	shortest, _ := itertools.MinByKey[string](iter, func(v string) int64 {
+		return int64(len(v))
+	})
+
+	assert.Equal(t, "a", shortest)
+}
@@ -0,0 +1,39 @@
+package itertools
+
+import "github.com/skylissh/std-go/itertools/iters"
+
+// Returns an iterator that maps the values of the original iterator with the
+// function f, running up to workers calls concurrently, and yields the
+// results in the order of the original iterator.
+//
+// At most workers values are read ahead, so a slow consumer applies
+// backpressure. If f panics, the panic is propagated by the call to Next that
+// would return its result.
+//
+// # Example
+//
+//	urls := itertools.AsIter([]string{"https://a.com", "https://b.com"})
+//	bodies := itertools.ParMap[string](urls, fetch, 8)
+//
+//	assert.Equal(t, []string{"a", "b"}, bodies.Collect())
+func ParMap[T, E any](iter iters.Iterable[T], f func(value T) E, workers uint) *iters.ParMap[T, E] {
+	return iters.NewParMap(iter, f, workers, true)
+}
+
+// Returns an iterator that maps the values of the original iterator with the
+// function f, running up to workers calls concurrently, and yields the
+// results as soon as they are ready.
+//
+// At most workers values are read ahead, so a slow consumer applies
+// backpressure. If f panics, the panic is propagated by a following call to
+// Next.
+//
+// # Example
+//
+//	urls := itertools.AsIter([]string{"https://a.com", "https://b.com"})
+//	bodies := itertools.ParMapUnordered[string](urls, fetch, 8)
+//
+//	assert.ElementsMatch(t, []string{"a", "b"}, bodies.Collect())
+func ParMapUnordered[T, E any](iter iters.Iterable[T], f func(value T) E, workers uint) *iters.ParMap[T, E] {
+	return iters.NewParMap(iter, f, workers, false)
+}
@@ -0,0 +1,78 @@
+package itertools_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func slowSquare(v int) int {
+	time.Sleep(time.Duration(10-v) * time.Millisecond)
+	return v * v
+}
+
+func TestParMap(t *testing.T) {
+	squares := itertools.ParMap[int](itertools.Range(0, 10, 1), slowSquare, 4)
+
+	assert.Equal(t, []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81}, squares.Collect())
+}
+
+func TestParMapUnordered(t *testing.T) {
+	squares := itertools.ParMapUnordered[int](itertools.Range(0, 10, 1), slowSquare, 4)
+
+	assert.ElementsMatch(t, []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81}, squares.Collect())
+}
+
+func TestParMapBounded(t *testing.T) {
+	var running, max int32
+
+	m := itertools.ParMap[int](itertools.Range(0, 20, 1), func(v int) int {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		for {
+			old := atomic.LoadInt32(&max)
+			if n <= old || atomic.CompareAndSwapInt32(&max, old, n) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+		return v
+	}, 3)
+
+	assert.Len(t, m.Collect(), 20)
+	assert.LessOrEqual(t, atomic.LoadInt32(&max), int32(3))
+}
+
+func TestParMapLazy(t *testing.T) {
+	var read int32
+	source := itertools.Map[int](itertools.Count(0), func(v int) int {
+		atomic.AddInt32(&read, 1)
+		return v
+	})
+
+	m := itertools.ParMap[int](source, func(v int) int { return v }, 2)
+
+	assert.Equal(t, 0, *m.Next())
+	assert.LessOrEqual(t, atomic.LoadInt32(&read), int32(3))
+}
+
+func TestParMapPanic(t *testing.T) {
+	m := itertools.ParMap[int](itertools.Range(0, 5, 1), func(v int) int {
+		if v == 2 {
+			panic("boom")
+		}
+
+		return v
+	}, 2)
+
+	assert.Equal(t, 0, *m.Next())
+	assert.Equal(t, 1, *m.Next())
+	assert.PanicsWithValue(t, "boom", func() {
+		m.Next()
+	})
+}
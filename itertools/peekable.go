@@ -0,0 +1,20 @@
+package itertools
+
+import "github.com/skylissh/std-go/itertools/iters"
+
+// Advances the peekable iterator and returns the next value, only if it is
+// equal to the given value. Otherwise the iterator is not advanced and nil is
+// returned.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]rune("(a)"))
+//	peekable := iter.Peekable(1)
+//
+//	assert.Equal(t, '(', *itertools.NextIfEq(peekable, '('))
+//	assert.Nil(t, itertools.NextIfEq(peekable, ')'))
+func NextIfEq[T comparable](peekable *iters.Peekable[T], value T) *T {
+	return peekable.NextIf(func(v T) bool {
+		return v == value
+	})
+}
@@ -0,0 +1,16 @@
+package itertools_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextIfEq(t *testing.T) {
+	peekable := itertools.AsIter([]rune("(a)")).Peekable(1)
+
+	assert.Equal(t, '(', *itertools.NextIfEq(peekable, '('))
+	assert.Nil(t, itertools.NextIfEq(peekable, ')'))
+	assert.Equal(t, 'a', *peekable.Next())
+}
@@ -0,0 +1,21 @@
+package itertools
+
+import "github.com/skylissh/std-go/itertools/iters"
+
+// Returns an iterator that yields the intermediate states of folding the
+// values of the original iterator with the function f, starting from init.
+//
+// Unlike Reduce, the state can have a different type than the values, and
+// every state is yielded lazily instead of only the final one.
+//
+// # Example
+//
+//	iter := itertools.AsIter([]int{1, 2, 3})
+//	sums := itertools.Scan[int](iter, 0, func(acc, value int) int {
+//		return acc + value
+//	})
+//
+//	assert.Equal(t, []int{1, 3, 6}, sums.Collect())
+func Scan[T, S any](iter iters.Iterable[T], init S, f func(acc S, value T) S) *iters.Scan[T, S] {
+	return iters.NewScan(iter, init, f)
+}
@@ -0,0 +1,36 @@
+package itertools_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScan(t *testing.T) {
+	iter := itertools.AsIter([]int{1, 2, 3})
+	sums := itertools.Scan[int](iter, 0, func(acc, value int) int {
+		return acc + value
+	})
+
+	assert.Equal(t, []int{1, 3, 6}, sums.Collect())
+}
+
+func TestScanStateMachine(t *testing.T) {
+	iter := itertools.AsIter([]rune("a\"b c\"d"))
+	quoted := itertools.Scan[rune](iter, false, func(inside bool, r rune) bool {
+		return inside != (r == '"')
+	})
+
+	assert.Equal(t, []bool{false, true, true, true, true, false, false}, quoted.Collect())
+}
+
+func TestScanClone(t *testing.T) {
+	iter := itertools.AsIter([]int{1, 2, 3})
+	lengths := itertools.Scan[int](iter, "", func(acc string, value int) string {
+		return acc + "x"
+	})
+	lengths.Next()
+
+	assert.Equal(t, []string{"x", "xx", "xxx"}, lengths.Clone().Collect())
+}
@@ -0,0 +1,83 @@
+//go:build go1.23
+
+package itertools
+
+import (
+	"iter"
+
+	"github.com/skylissh/std-go/itertools/iters"
+)
+
+// Returns an iter.Seq[T] that yields the values of the iterator, so it can be
+// used in a range loop.
+//
+// # Example
+//
+//	for v := range itertools.ToSeq[int](itertools.Range(0, 3, 1)) {
+//		fmt.Println(v)
+//	}
+func ToSeq[T any](iterable iters.Iterable[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := iterable.Next(); v != nil; v = iterable.Next() {
+			if !yield(*v) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iter.Seq2[A, B] that yields the values of an iterator of pairs,
+// like the ones created by Zip or Enumerate.
+//
+// # Example
+//
+//	names := itertools.AsIter([]string{"a", "b"})
+//
+//	for i, name := range itertools.ToSeq2[int, string](itertools.Enumerate[string](names)) {
+//		fmt.Println(i, name)
+//	}
+func ToSeq2[A, B any](iterable iters.Iterable[iters.Pair[A, B]]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		for v := iterable.Next(); v != nil; v = iterable.Next() {
+			if !yield(v.First, v.Second) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iterator that pulls the values of the sequence, so all the
+// adapters of this package can be used with it.
+//
+// If the iterator is not consumed until the end, Stop must be called to
+// release the sequence.
+//
+// # Example
+//
+//	values := itertools.FromSeq(slices.Values([]int{1, 2, 3, 4}))
+//	evens := values.Filter(func(v int) bool { return v%2 == 0 })
+//
+//	assert.Equal(t, []int{2, 4}, evens.Collect())
+func FromSeq[T any](seq iter.Seq[T]) *iters.Pull[T] {
+	return iters.NewPull(seq)
+}
+
+// Returns an iterator of pairs that pulls the values of the sequence.
+//
+// If the iterator is not consumed until the end, Stop must be called to
+// release the sequence.
+//
+// # Example
+//
+//	pairs := itertools.FromSeq2(maps.All(map[string]int{"a": 1}))
+//
+//	assert.Equal(t, iters.NewPair("a", 1), *pairs.Next())
+func FromSeq2[A, B any](seq iter.Seq2[A, B]) *iters.Pull[iters.Pair[A, B]] {
+	return iters.NewPull(func(yield func(iters.Pair[A, B]) bool) {
+		for a, b := range seq {
+			if !yield(iters.Pair[A, B]{First: a, Second: b}) {
+				return
+			}
+		}
+	})
+}
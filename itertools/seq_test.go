@@ -0,0 +1,63 @@
+//go:build go1.23
+
+package itertools_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/skylissh/std-go/itertools/iters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSeq(t *testing.T) {
+	values := make([]int, 0)
+
+	for v := range itertools.ToSeq[int](itertools.Range(0, 10, 1)) {
+		if v == 3 {
+			break
+		}
+
+		values = append(values, v)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, values)
+}
+
+func TestToSeq2(t *testing.T) {
+	names := itertools.AsIter([]string{"a", "b"})
+	expect := map[int]string{0: "a", 1: "b"}
+
+	assert.Equal(t, expect, maps.Collect(itertools.ToSeq2[int, string](itertools.Enumerate[string](names))))
+}
+
+func TestIteratorSeq(t *testing.T) {
+	evens := itertools.Range(0, 10, 1).Filter(func(v int) bool { return v%2 == 0 }).Take(3)
+
+	assert.Equal(t, []int{0, 2, 4}, slices.Collect(evens.Seq()))
+}
+
+func TestFromSeq(t *testing.T) {
+	values := itertools.FromSeq(slices.Values([]int{1, 2, 3, 4}))
+	evens := values.Filter(func(v int) bool { return v%2 == 0 })
+
+	assert.Equal(t, []int{2, 4}, evens.Collect())
+	assert.Equal(t, []int{1, 2, 3, 4}, values.Clone().Collect())
+}
+
+func TestFromSeqStop(t *testing.T) {
+	values := itertools.FromSeq(slices.Values([]int{1, 2, 3}))
+
+	assert.Equal(t, 1, *values.Next())
+	values.Stop()
+	assert.Nil(t, values.Next())
+}
+
+func TestFromSeq2(t *testing.T) {
+	pairs := itertools.FromSeq2(slices.All([]string{"a", "b"}))
+	expect := []iters.Pair[int, string]{iters.NewPair(0, "a"), iters.NewPair(1, "b")}
+
+	assert.Equal(t, expect, pairs.Collect())
+}
@@ -0,0 +1,23 @@
+package itertools
+
+import (
+	"github.com/skylissh/std-go/cmp"
+	"github.com/skylissh/std-go/itertools/iters"
+)
+
+// Collect the values of the iterator into a slice, sorted in ascending order
+// using the comparator. The sort is stable, so values that are equal for the
+// comparator keep their order.
+//
+// # Example
+//
+//	people := itertools.AsIter([]Person{{"Bob", 30}, {"Alice", 25}})
+//	sorted := itertools.SortedBy[Person](people, cmp.By(
+//		func(value, other Person) int { return value.Age - other.Age },
+//		func(value, other Person) int { return strings.Compare(value.Name, other.Name) },
+//	))
+//
+//	assert.Equal(t, "Alice", sorted[0].Name)
+func SortedBy[T any](iter iters.Iterable[T], comparator *cmp.Comparator[T]) []T {
+	return iters.NewIterator(iter).SortedBy(comparator)
+}
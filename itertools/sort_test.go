@@ -0,0 +1,25 @@
+package itertools_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/skylissh/std-go/cmp"
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func TestSortedBy(t *testing.T) {
+	people := itertools.AsIter([]person{{"Bob", 30}, {"Carol", 25}, {"Alice", 25}})
+	sorted := itertools.SortedBy[person](people, cmp.By(
+		func(value, other person) int { return value.age - other.age },
+		func(value, other person) int { return strings.Compare(value.name, other.name) },
+	))
+
+	assert.Equal(t, []person{{"Alice", 25}, {"Carol", 25}, {"Bob", 30}}, sorted)
+}
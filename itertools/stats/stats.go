@@ -0,0 +1,204 @@
+// This package provides numeric terminal operations for iterators of integers
+// or floats, like the sum, the mean or the percentiles of the values.
+//
+// All the operations consume the iterator, and return false as the second
+// value if the iterator is empty.
+//
+// # Example
+//
+//	mean, _ := stats.Mean[int](itertools.AsIter([]int{1, 2, 3, 4}))
+//	p50, _ := stats.Percentile[int](itertools.AsIter([]int{1, 2, 3, 4}), 50)
+//
+//	assert.Equal(t, 2.5, mean)
+//	assert.Equal(t, 2.5, p50)
+package stats
+
+import (
+	"math"
+	"sort"
+
+	"github.com/skylissh/std-go/itertools/iters"
+)
+
+// Returns the sum of the values of the iterator.
+//
+// # Example
+//
+//	sum, ok := stats.Sum[int](itertools.AsIter([]int{1, 2, 3}))
+//
+//	assert.True(t, ok)
+//	assert.Equal(t, 6, sum)
+func Sum[T iters.Number](iter iters.Iterable[T]) (T, bool) {
+	return reduce(iter, func(acc, value T) T {
+		return acc + value
+	})
+}
+
+// Returns the product of the values of the iterator.
+//
+// # Example
+//
+//	product, _ := stats.Product[int](itertools.AsIter([]int{2, 3, 4}))
+//
+//	assert.Equal(t, 24, product)
+func Product[T iters.Number](iter iters.Iterable[T]) (T, bool) {
+	return reduce(iter, func(acc, value T) T {
+		return acc * value
+	})
+}
+
+// Returns the arithmetic mean of the values of the iterator.
+//
+// # Example
+//
+//	mean, _ := stats.Mean[int](itertools.AsIter([]int{1, 2, 3, 4}))
+//
+//	assert.Equal(t, 2.5, mean)
+func Mean[T iters.Number](iter iters.Iterable[T]) (float64, bool) {
+	mean, _, n := welford(iter)
+	return mean, n > 0
+}
+
+// Returns the population variance of the values of the iterator.
+//
+// It is computed in a single pass with Welford's online algorithm, which is
+// numerically stable.
+//
+// # Example
+//
+//	variance, _ := stats.Variance[int](itertools.AsIter([]int{2, 4, 4, 4, 5, 5, 7, 9}))
+//
+//	assert.Equal(t, 4.0, variance)
+func Variance[T iters.Number](iter iters.Iterable[T]) (float64, bool) {
+	_, m2, n := welford(iter)
+
+	if n == 0 {
+		return 0, false
+	}
+
+	return m2 / float64(n), true
+}
+
+// Returns the population standard deviation of the values of the iterator,
+// that is the square root of the variance.
+//
+// # Example
+//
+//	stddev, _ := stats.StdDev[int](itertools.AsIter([]int{2, 4, 4, 4, 5, 5, 7, 9}))
+//
+//	assert.Equal(t, 2.0, stddev)
+func StdDev[T iters.Number](iter iters.Iterable[T]) (float64, bool) {
+	variance, ok := Variance(iter)
+	return math.Sqrt(variance), ok
+}
+
+// Returns the median of the values of the iterator. With an even number of
+// values, it is the mean of the two middle values.
+//
+// The values are collected and sorted, use a TDigest to approximate it in
+// constant memory.
+//
+// # Example
+//
+//	median, _ := stats.Median[int](itertools.AsIter([]int{3, 1, 4, 2}))
+//
+//	assert.Equal(t, 2.5, median)
+func Median[T iters.Number](iter iters.Iterable[T]) (float64, bool) {
+	return Percentile(iter, 50)
+}
+
+// Returns the p-th percentile of the values of the iterator, with p between
+// 0 and 100, interpolating linearly between the closest values.
+//
+// The values are collected and sorted, use ApproxPercentile or a TDigest to
+// approximate it in constant memory. Panics if p is out of range.
+//
+// # Example
+//
+//	p90, _ := stats.Percentile[int](itertools.Range(1, 11, 1), 90)
+//
+//	assert.InDelta(t, 9.1, p90, 1e-9)
+func Percentile[T iters.Number](iter iters.Iterable[T], p float64) (float64, bool) {
+	if math.IsNaN(p) || p < 0 || p > 100 {
+		panic("The percentile must be between 0 and 100")
+	}
+
+	values := make([]float64, 0)
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		values = append(values, float64(*v))
+	}
+
+	if len(values) == 0 {
+		return 0, false
+	}
+
+	sort.Float64s(values)
+
+	rank := p / 100 * float64(len(values)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	return values[lower] + (values[upper]-values[lower])*(rank-float64(lower)), true
+}
+
+// Returns an approximation of the p-th percentile of the values of the
+// iterator, with p between 0 and 100, using a TDigest with the given
+// compression.
+//
+// Only the digest is kept in memory, so it works with streams of any size.
+// Higher compressions are more accurate but use more memory, 100 is a good
+// default. Panics if p is out of range.
+//
+// # Example
+//
+//	p99, _ := stats.ApproxPercentile[float64](latencies, 99, 100)
+func ApproxPercentile[T iters.Number](iter iters.Iterable[T], p float64, compression float64) (float64, bool) {
+	if math.IsNaN(p) || p < 0 || p > 100 {
+		panic("The percentile must be between 0 and 100")
+	}
+
+	digest := NewTDigest(compression)
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		digest.Add(float64(*v))
+	}
+
+	if digest.Count() == 0 {
+		return 0, false
+	}
+
+	return digest.Quantile(p / 100), true
+}
+
+// Reduces the iterator with the function, returning false if it is empty.
+func reduce[T iters.Number](iter iters.Iterable[T], f func(acc, value T) T) (T, bool) {
+	first := iter.Next()
+
+	if first == nil {
+		return 0, false
+	}
+
+	acc := *first
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		acc = f(acc, *v)
+	}
+
+	return acc, true
+}
+
+// Returns the mean, the sum of squared differences from the mean, and the
+// number of values of the iterator, computed with Welford's algorithm.
+func welford[T iters.Number](iter iters.Iterable[T]) (float64, float64, int) {
+	var mean, m2 float64
+	n := 0
+
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		n++
+
+		x := float64(*v)
+		delta := x - mean
+		mean += delta / float64(n)
+		m2 += delta * (x - mean)
+	}
+
+	return mean, m2, n
+}
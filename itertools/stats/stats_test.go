@@ -0,0 +1,131 @@
+package stats_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/skylissh/std-go/itertools/iters"
+	"github.com/skylissh/std-go/itertools/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum(t *testing.T) {
+	sum, ok := stats.Sum[int](itertools.AsIter([]int{1, 2, 3}))
+
+	assert.True(t, ok)
+	assert.Equal(t, 6, sum)
+}
+
+func TestProduct(t *testing.T) {
+	product, ok := stats.Product[float64](itertools.AsIter([]float64{2, 3, 0.5}))
+
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, product)
+}
+
+func TestMean(t *testing.T) {
+	mean, ok := stats.Mean[int](itertools.AsIter([]int{1, 2, 3, 4}))
+
+	assert.True(t, ok)
+	assert.Equal(t, 2.5, mean)
+}
+
+func TestVariance(t *testing.T) {
+	values := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	variance, ok := stats.Variance[int](itertools.AsIter(values))
+	assert.True(t, ok)
+	assert.Equal(t, 4.0, variance)
+
+	stddev, ok := stats.StdDev[int](itertools.AsIter(values))
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, stddev)
+}
+
+func TestVarianceStable(t *testing.T) {
+	values := []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}
+	variance, _ := stats.Variance[float64](itertools.AsIter(values))
+
+	assert.InDelta(t, 22.5, variance, 1e-6)
+}
+
+func TestMedian(t *testing.T) {
+	odd, _ := stats.Median[int](itertools.AsIter([]int{3, 1, 2}))
+	even, _ := stats.Median[int](itertools.AsIter([]int{3, 1, 4, 2}))
+
+	assert.Equal(t, 2.0, odd)
+	assert.Equal(t, 2.5, even)
+}
+
+func TestPercentile(t *testing.T) {
+	p0, _ := stats.Percentile[int](itertools.Range(1, 11, 1), 0)
+	p90, _ := stats.Percentile[int](itertools.Range(1, 11, 1), 90)
+	p100, _ := stats.Percentile[int](itertools.Range(1, 11, 1), 100)
+
+	assert.Equal(t, 1.0, p0)
+	assert.InDelta(t, 9.1, p90, 1e-9)
+	assert.Equal(t, 10.0, p100)
+}
+
+func TestPercentileOutOfRange(t *testing.T) {
+	assert.Panics(t, func() {
+		stats.Percentile[int](itertools.Range(1, 11, 1), 101)
+	})
+	assert.Panics(t, func() {
+		stats.Percentile[int](itertools.Range(1, 11, 1), math.NaN())
+	})
+	assert.Panics(t, func() {
+		stats.ApproxPercentile[int](itertools.Range(1, 11, 1), math.NaN(), 100)
+	})
+	assert.Panics(t, func() {
+		stats.NewTDigest(100).Quantile(math.NaN())
+	})
+}
+
+func TestEmpty(t *testing.T) {
+	empty := func() *iters.Iter[int] { return itertools.AsIter([]int{}) }
+
+	_, ok := stats.Sum[int](empty())
+	assert.False(t, ok)
+	_, ok = stats.Product[int](empty())
+	assert.False(t, ok)
+	_, ok = stats.Mean[int](empty())
+	assert.False(t, ok)
+	_, ok = stats.Variance[int](empty())
+	assert.False(t, ok)
+	_, ok = stats.StdDev[int](empty())
+	assert.False(t, ok)
+	_, ok = stats.Median[int](empty())
+	assert.False(t, ok)
+	_, ok = stats.Percentile[int](empty(), 50)
+	assert.False(t, ok)
+	_, ok = stats.ApproxPercentile[int](empty(), 50, 100)
+	assert.False(t, ok)
+}
+
+func TestApproxPercentile(t *testing.T) {
+	values := rand.New(rand.NewSource(1)).Perm(100000)
+
+	for _, p := range []float64{1, 50, 90, 99, 99.9} {
+		approx, ok := stats.ApproxPercentile[int](itertools.AsIter(values), p, 100)
+		exact, _ := stats.Percentile[int](itertools.AsIter(values), p)
+
+		assert.True(t, ok)
+		assert.InDelta(t, exact, approx, 0.005*float64(len(values)), "percentile %v", p)
+	}
+}
+
+func TestTDigest(t *testing.T) {
+	digest := stats.NewTDigest(100)
+
+	assert.True(t, math.IsNaN(digest.Quantile(0.5)))
+
+	itertools.Range(0.0, 1000, 1).ForEach(digest.Add)
+
+	assert.Equal(t, 1000, digest.Count())
+	assert.Equal(t, 0.0, digest.Quantile(0))
+	assert.Equal(t, 999.0, digest.Quantile(1))
+	assert.InDelta(t, 500, digest.Quantile(0.5), 10)
+}
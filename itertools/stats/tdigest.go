@@ -0,0 +1,152 @@
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// NewTDigest returns a new empty TDigest with the given compression.
+//
+// Higher compressions are more accurate but keep more centroids, 100 is a
+// good default. The compression must be greater than 0, otherwise it panics.
+func NewTDigest(compression float64) *TDigest {
+	if math.IsNaN(compression) || compression <= 0 {
+		panic("The compression must be greater than 0")
+	}
+
+	return &TDigest{compression: compression, min: math.Inf(1), max: math.Inf(-1)}
+}
+
+// TDigest is a sketch that approximates the quantiles of a stream of values
+// in constant memory.
+//
+// The values are summarized in centroids, which are smaller near the
+// extremes, so the tail quantiles like the 99th percentile are more accurate
+// than the median.
+type TDigest struct {
+	compression float64
+	centroids   []centroid
+	buffer      []float64
+	count       float64
+	min         float64
+	max         float64
+}
+
+// A cluster of values of the digest, represented by their mean and count.
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// Adds a value to the digest.
+//
+// # Example
+//
+//	digest := stats.NewTDigest(100)
+//	itertools.Range(0.0, 1000, 1).ForEach(digest.Add)
+//
+//	assert.InDelta(t, 500, digest.Quantile(0.5), 10)
+func (d *TDigest) Add(value float64) {
+	d.buffer = append(d.buffer, value)
+	d.count++
+	d.min = math.Min(d.min, value)
+	d.max = math.Max(d.max, value)
+
+	if float64(len(d.buffer)) >= 5*d.compression {
+		d.compress()
+	}
+}
+
+// Returns the number of values added to the digest.
+func (d *TDigest) Count() int {
+	return int(d.count)
+}
+
+// Returns an approximation of the q-th quantile of the values, with q between
+// 0 and 1.
+//
+// Returns NaN if the digest is empty. Panics if q is out of range.
+func (d *TDigest) Quantile(q float64) float64 {
+	if math.IsNaN(q) || q < 0 || q > 1 {
+		panic("The quantile must be between 0 and 1")
+	}
+
+	d.compress()
+
+	if len(d.centroids) == 0 {
+		return math.NaN()
+	}
+
+	if len(d.centroids) == 1 {
+		return d.centroids[0].mean
+	}
+
+	target := q * d.count
+	first, last := d.centroids[0], d.centroids[len(d.centroids)-1]
+
+	if target <= first.weight/2 {
+		return d.min + (first.mean-d.min)*target/(first.weight/2)
+	}
+
+	if target >= d.count-last.weight/2 {
+		return last.mean + (d.max-last.mean)*(target-(d.count-last.weight/2))/(last.weight/2)
+	}
+
+	// The position of the center of each centroid in the sorted values.
+	center := first.weight / 2
+	for i := 1; i < len(d.centroids); i++ {
+		prev, next := d.centroids[i-1], d.centroids[i]
+		step := (prev.weight + next.weight) / 2
+
+		if target <= center+step {
+			return prev.mean + (next.mean-prev.mean)*(target-center)/step
+		}
+
+		center += step
+	}
+
+	return last.mean
+}
+
+// Merges the buffered values with the centroids, keeping each centroid under
+// the size allowed by its quantile.
+func (d *TDigest) compress() {
+	if len(d.buffer) == 0 {
+		return
+	}
+
+	all := make([]centroid, 0, len(d.centroids)+len(d.buffer))
+	all = append(all, d.centroids...)
+	for _, v := range d.buffer {
+		all = append(all, centroid{v, 1})
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].mean < all[j].mean
+	})
+
+	merged := make([]centroid, 0, len(d.centroids))
+	// The weight of the centroids before the last merged one.
+	before := 0.0
+
+	for _, c := range all {
+		if n := len(merged); n > 0 {
+			last := &merged[n-1]
+			weight := last.weight + c.weight
+			q := (before + weight/2) / d.count
+
+			if weight <= math.Max(1, 4*d.count*q*(1-q)/d.compression) {
+				last.mean += (c.mean - last.mean) * c.weight / weight
+				last.weight = weight
+				continue
+			}
+
+			before += last.weight
+		}
+
+		merged = append(merged, c)
+	}
+
+	d.centroids = merged
+	d.buffer = d.buffer[:0]
+}
@@ -0,0 +1,268 @@
+// This package provides iterators over text, to start pipelines from files,
+// network connections or strings without splitting them beforehand.
+//
+// The iterators that read from an io.Reader implement iters.Fallible, so the
+// read errors are reported by their Err method once they stop.
+//
+// # Example
+//
+// The following example counts the lines of a log file that contain an error.
+//
+//	file, _ := os.Open("app.log")
+//	defer file.Close()
+//
+//	lines := text.Lines(file)
+//	errors := lines.Filter(func(line string) bool {
+//		return strings.Contains(line, "ERROR")
+//	}).Collect()
+//
+//	if err := lines.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+package text
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"regexp"
+	"regexp/syntax"
+	"unicode/utf8"
+
+	"github.com/skylissh/std-go/itertools/iters"
+)
+
+// Scanner is an iterator over the tokens of an io.Reader, split by a
+// bufio.SplitFunc.
+//
+// The reader can't be read again, so the scanner is not cloneable, and
+// cloning an adapter that wraps it panics.
+//
+// This struct is not intended to be used directly, is created by the Lines,
+// Words and SplitFunc functions.
+type Scanner struct {
+	scanner *bufio.Scanner
+
+	*iters.FromFunc[string]
+}
+
+// Sets the maximum size of a token, 64KB by default. Longer tokens stop the
+// iterator with bufio.ErrTooLong.
+//
+// It must be called before the first call to Next, otherwise it panics. The
+// size must not be negative, otherwise it panics too.
+//
+// # Example
+//
+//	lines := text.Lines(file).MaxTokenSize(1024 * 1024)
+func (s *Scanner) MaxTokenSize(max int) *Scanner {
+	if max < 0 {
+		panic("The max token size must not be negative")
+	}
+
+	size := 4096
+	if max < size {
+		size = max
+	}
+
+	s.scanner.Buffer(make([]byte, 0, size), max)
+	return s
+}
+
+// Returns the first error that happened while reading, or nil if the reader
+// was read until io.EOF.
+func (s *Scanner) Err() error {
+	return s.scanner.Err()
+}
+
+// Returns an iterator over the tokens of the reader, split by the function.
+//
+// # Example
+//
+//	fields := text.SplitFunc(reader, func(data []byte, atEOF bool) (int, []byte, error) {
+//		// ...
+//	})
+func SplitFunc(r io.Reader, split bufio.SplitFunc) *Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(split)
+
+	return &Scanner{scanner, iters.NewFromFunc(func() (string, bool) {
+		if !scanner.Scan() {
+			return "", false
+		}
+
+		return scanner.Text(), true
+	})}
+}
+
+// Returns an iterator over the lines of the reader, without the end-of-line
+// markers.
+//
+// # Example
+//
+//	lines := text.Lines(strings.NewReader("a\nb\r\nc"))
+//
+//	assert.Equal(t, []string{"a", "b", "c"}, lines.Collect())
+func Lines(r io.Reader) *Scanner {
+	return SplitFunc(r, bufio.ScanLines)
+}
+
+// Returns an iterator over the words of the reader, separated by spaces.
+//
+// # Example
+//
+//	words := text.Words(strings.NewReader("hello  big\nworld"))
+//
+//	assert.Equal(t, []string{"hello", "big", "world"}, words.Collect())
+func Words(r io.Reader) *Scanner {
+	return SplitFunc(r, bufio.ScanWords)
+}
+
+// ByteReader is an iterator over the bytes of an io.Reader.
+//
+// Like Scanner, it is not cloneable.
+//
+// This struct is not intended to be used directly, is created by the Bytes
+// function.
+type ByteReader struct {
+	err error
+
+	*iters.FromFunc[byte]
+}
+
+// Returns the first error that happened while reading, or nil if the reader
+// was read until io.EOF.
+func (b *ByteReader) Err() error {
+	return b.err
+}
+
+// Returns an iterator over the bytes of the reader. The reader is buffered,
+// so it is read in blocks.
+//
+// # Example
+//
+//	bytes := text.Bytes(strings.NewReader("ab"))
+//
+//	assert.Equal(t, []byte{'a', 'b'}, bytes.Collect())
+func Bytes(r io.Reader) *ByteReader {
+	reader := bufio.NewReader(r)
+	b := &ByteReader{}
+
+	b.FromFunc = iters.NewFromFunc(func() (byte, bool) {
+		c, err := reader.ReadByte()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				b.err = err
+			}
+
+			return 0, false
+		}
+
+		return c, true
+	})
+
+	return b
+}
+
+// Returns an iterator over the runes of the string. Invalid UTF-8 sequences
+// are yielded as utf8.RuneError, one byte at a time.
+//
+// # Example
+//
+//	runes := text.Runes("héllo")
+//
+//	assert.Equal(t, []rune{'h', 'é', 'l', 'l', 'o'}, runes.Collect())
+func Runes(s string) *iters.FromFunc[rune] {
+	return iters.NewFromFunc(func() (rune, bool) {
+		if len(s) == 0 {
+			return 0, false
+		}
+
+		r, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+		return r, true
+	})
+}
+
+// Returns an iterator over the successive matches of the regular expression
+// in the string, the same as regexp.FindAllString.
+//
+// Each match is searched when the iterator advances. The expressions with
+// assertions that depend on the text before the match, like ^ with the m
+// flag, \A or \b, can't be searched from the end of the previous match, so
+// all their matches are found when the iterator is created.
+//
+// # Example
+//
+//	numbers := text.RegexMatches(regexp.MustCompile(`\d+`), "a1b22c333")
+//
+//	assert.Equal(t, []string{"1", "22", "333"}, numbers.Collect())
+func RegexMatches(re *regexp.Regexp, s string) *iters.FromFunc[string] {
+	if parsed, err := syntax.Parse(re.String(), syntax.Perl); err != nil || lookBehind(parsed) {
+		matches := re.FindAllStringIndex(s, -1)
+
+		return iters.NewFromFunc(func() (string, bool) {
+			if len(matches) == 0 {
+				return "", false
+			}
+
+			match := matches[0]
+			matches = matches[1:]
+			return s[match[0]:match[1]], true
+		})
+	}
+
+	pos, last := 0, -1
+
+	return iters.NewFromFunc(func() (string, bool) {
+		for pos <= len(s) {
+			loc := re.FindStringIndex(s[pos:])
+			if loc == nil {
+				break
+			}
+
+			start, end := pos+loc[0], pos+loc[1]
+			accept := true
+
+			if end == pos {
+				// Like regexp.FindAllString, an empty match right after the
+				// previous match is skipped, and the search moves one rune.
+				accept = start != last
+
+				if pos < len(s) {
+					_, size := utf8.DecodeRuneInString(s[pos:])
+					pos += size
+				} else {
+					pos++
+				}
+			} else {
+				pos = end
+			}
+
+			last = end
+			if accept {
+				return s[start:end], true
+			}
+		}
+
+		pos = len(s) + 1
+		return "", false
+	})
+}
+
+// Reports whether the regular expression has an assertion that depends on
+// the text before the position where it is searched.
+func lookBehind(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpBeginLine, syntax.OpBeginText, syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return true
+	}
+
+	for _, sub := range re.Sub {
+		if lookBehind(sub) {
+			return true
+		}
+	}
+
+	return false
+}
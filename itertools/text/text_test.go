@@ -0,0 +1,138 @@
+package text_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/skylissh/std-go/itertools/iters"
+	"github.com/skylissh/std-go/itertools/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	lines := text.Lines(strings.NewReader("a\nb\r\nc"))
+
+	assert.Equal(t, []string{"a", "b", "c"}, lines.Collect())
+	assert.NoError(t, lines.Err())
+}
+
+func TestLinesFilter(t *testing.T) {
+	lines := text.Lines(strings.NewReader("INFO start\nERROR boom\nINFO end\nERROR again"))
+	failures := lines.Filter(func(line string) bool {
+		return strings.HasPrefix(line, "ERROR")
+	})
+
+	assert.Equal(t, []string{"ERROR boom", "ERROR again"}, failures.Collect())
+}
+
+func TestLinesTooLong(t *testing.T) {
+	lines := text.Lines(strings.NewReader("short\n" + strings.Repeat("x", 100) + "\nend")).MaxTokenSize(16)
+
+	assert.Equal(t, []string{"short"}, lines.Collect())
+	assert.ErrorIs(t, lines.Err(), bufio.ErrTooLong)
+}
+
+func TestMaxTokenSizeNegative(t *testing.T) {
+	assert.PanicsWithValue(t, "The max token size must not be negative", func() {
+		text.Lines(strings.NewReader("")).MaxTokenSize(-1)
+	})
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("broken")
+}
+
+func TestLinesError(t *testing.T) {
+	lines := text.Lines(io.MultiReader(strings.NewReader("a\n"), failingReader{}))
+
+	assert.Equal(t, []string{"a"}, lines.Collect())
+	assert.EqualError(t, lines.Err(), "broken")
+}
+
+func TestLinesFallible(t *testing.T) {
+	var _ iters.Fallible[string] = text.Lines(failingReader{})
+
+	_, err := itertools.Try[string](text.Lines(failingReader{})).TryCollect()
+
+	assert.EqualError(t, err, "broken")
+}
+
+func TestWords(t *testing.T) {
+	words := text.Words(strings.NewReader("hello  big\nworld"))
+
+	assert.Equal(t, []string{"hello", "big", "world"}, words.Collect())
+}
+
+func TestSplitFunc(t *testing.T) {
+	runes := text.SplitFunc(strings.NewReader("añb"), bufio.ScanRunes)
+
+	assert.Equal(t, []string{"a", "ñ", "b"}, runes.Collect())
+}
+
+func TestBytes(t *testing.T) {
+	bytes := text.Bytes(strings.NewReader("ab"))
+
+	assert.Equal(t, []byte{'a', 'b'}, bytes.Collect())
+	assert.NoError(t, bytes.Err())
+}
+
+func TestBytesError(t *testing.T) {
+	bytes := text.Bytes(failingReader{})
+
+	assert.Empty(t, bytes.Collect())
+	assert.EqualError(t, bytes.Err(), "broken")
+}
+
+func TestRunes(t *testing.T) {
+	assert.Equal(t, []rune{'h', 'é', 'l', 'l', 'o'}, text.Runes("héllo").Collect())
+}
+
+func TestRegexMatches(t *testing.T) {
+	numbers := text.RegexMatches(regexp.MustCompile(`\d+`), "a1b22c333")
+
+	assert.Equal(t, []string{"1", "22", "333"}, numbers.Collect())
+}
+
+func TestRegexMatchesLikeFindAll(t *testing.T) {
+	cases := []struct{ pattern, s string }{
+		{`a*`, "baaac"},
+		{`x*`, "héllo"},
+		{`a|`, "abab"},
+		{`\w+`, "foo, bar baz"},
+		{`b*`, ""},
+		{`^a`, "aaa"},
+		{`(?m)^\w`, "ab\ncd"},
+		{`\Aa|b`, "abab"},
+		{`\bx\w*`, "xa yxb xc"},
+		{`\Bo`, "foo ox"},
+		{`a$`, "aa"},
+	}
+
+	for _, c := range cases {
+		re := regexp.MustCompile(c.pattern)
+
+		assert.Equal(t, re.FindAllString(c.s, -1), text.RegexMatches(re, c.s).Collect(), c.pattern)
+	}
+}
+
+func TestReadersNotCloneable(t *testing.T) {
+	lines := text.Lines(strings.NewReader("a\nb"))
+	bytes := text.Bytes(strings.NewReader("ab"))
+
+	assert.PanicsWithValue(t, "The iterator is not cloneable", func() {
+		itertools.Cycle[string](lines)
+	})
+	assert.PanicsWithValue(t, "The iterator is not cloneable", func() {
+		lines.Filter(func(string) bool { return true }).Clone()
+	})
+	assert.PanicsWithValue(t, "The iterator is not cloneable", func() {
+		itertools.Cycle[byte](bytes)
+	})
+}
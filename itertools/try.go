@@ -0,0 +1,34 @@
+package itertools
+
+import "github.com/skylissh/std-go/itertools/iters"
+
+// Returns a fallible iterator with the values of the iterator.
+//
+// If the iterator implements iters.Fallible, like the ones created by
+// WithContext, its error is returned once it stops. Otherwise the fallible
+// iterator never fails.
+//
+// # Example
+//
+//	iter := itertools.Try[string](itertools.AsIter([]string{"1", "2"}))
+//	numbers, err := itertools.TryMap[string](iter, strconv.Atoi).TryCollect()
+//
+//	assert.NoError(t, err)
+//	assert.Equal(t, []int{1, 2}, numbers)
+func Try[T any](iter iters.Iterable[T]) *iters.Try[T] {
+	return iters.NewTry(iter)
+}
+
+// Returns a fallible iterator that maps the values of the fallible iterator
+// with the function f, stopping at the first error.
+//
+// # Example
+//
+//	iter := itertools.Try[string](itertools.AsIter([]string{"1", "x", "3"}))
+//	numbers, err := itertools.TryMap[string](iter, strconv.Atoi).TryCollect()
+//
+//	assert.Equal(t, []int{1}, numbers)
+//	assert.Error(t, err)
+func TryMap[T, E any](iter iters.TryIterable[T], f func(value T) (E, error)) *iters.TryMap[T, E] {
+	return iters.NewTryMap(iter, f)
+}
@@ -0,0 +1,82 @@
+package itertools_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTryMap(t *testing.T) {
+	iter := itertools.Try[string](itertools.AsIter([]string{"1", "2", "3"}))
+	numbers, err := itertools.TryMap[string](iter, strconv.Atoi).TryCollect()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, numbers)
+}
+
+func TestTryMapError(t *testing.T) {
+	iter := itertools.Try[string](itertools.AsIter([]string{"1", "x", "3"}))
+	numbers := itertools.TryMap[string](iter, strconv.Atoi)
+	values, err := numbers.TryCollect()
+
+	assert.Error(t, err)
+	assert.Equal(t, []int{1}, values)
+
+	// The error is sticky, the following values are not produced.
+	v, again := numbers.Next()
+	assert.Nil(t, v)
+	assert.Equal(t, err, again)
+}
+
+func TestTryFilter(t *testing.T) {
+	tooBig := errors.New("too big")
+	iter := itertools.Try[int](itertools.Range(0, 10, 1))
+	evens, err := iter.TryFilter(func(v int) (bool, error) {
+		if v > 5 {
+			return false, tooBig
+		}
+
+		return v%2 == 0, nil
+	}).TryCollect()
+
+	assert.ErrorIs(t, err, tooBig)
+	assert.Equal(t, []int{0, 2, 4}, evens)
+}
+
+func TestTryForEach(t *testing.T) {
+	stop := errors.New("stop")
+	seen := 0
+
+	err := itertools.Try[int](itertools.Count(0)).TryForEach(func(v int) error {
+		seen++
+		if v == 2 {
+			return stop
+		}
+
+		return nil
+	})
+
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 3, seen)
+}
+
+func TestTryFallible(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := itertools.Try[int](itertools.WithContext[int](ctx, itertools.Count(0))).TryCollect()
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestUnwrap(t *testing.T) {
+	iter := itertools.Try[string](itertools.AsIter([]string{"1", "2", "x", "4"}))
+	numbers := itertools.TryMap[string](iter, strconv.Atoi).Unwrap()
+
+	assert.Equal(t, []int{1, 2}, numbers.Collect())
+	assert.Error(t, numbers.Err())
+}
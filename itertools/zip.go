@@ -0,0 +1,77 @@
+package itertools
+
+import "github.com/skylissh/std-go/itertools/iters"
+
+// Returns an iterator that yields pairs with the values of both iterators,
+// stopping when the shortest one is exhausted.
+//
+// # Example
+//
+//	numbers := itertools.AsIter([]int{1, 2, 3})
+//	names := itertools.AsIter([]string{"one", "two"})
+//
+//	pairs := itertools.Zip[int, string](numbers, names).Collect()
+//
+//	assert.Equal(t, []iters.Pair[int, string]{
+//		iters.NewPair(1, "one"),
+//		iters.NewPair(2, "two"),
+//	}, pairs)
+func Zip[A, B any](a iters.Iterable[A], b iters.Iterable[B]) *iters.Zip[A, B] {
+	return iters.NewZip(a, b)
+}
+
+// Returns an iterator that yields pairs with the values of both iterators,
+// stopping when the longest one is exhausted. The values of an exhausted
+// iterator are nil.
+//
+// # Example
+//
+//	numbers := itertools.AsIter([]int{1, 2})
+//	names := itertools.AsIter([]string{"one"})
+//
+//	zip := itertools.ZipLongest[int, string](numbers, names)
+//	zip.Next() // {&1, &"one"}
+//	zip.Next() // {&2, nil}
+//	zip.Next() // nil
+func ZipLongest[A, B any](a iters.Iterable[A], b iters.Iterable[B]) *iters.ZipLongest[A, B] {
+	return iters.NewZipLongest(a, b)
+}
+
+// Returns an iterator that yields triples with the values of the three
+// iterators, stopping when the shortest one is exhausted.
+//
+// # Example
+//
+//	numbers := itertools.AsIter([]int{1, 2})
+//	names := itertools.AsIter([]string{"one", "two"})
+//	evens := itertools.AsIter([]bool{false, true})
+//
+//	zip := itertools.Zip3[int, string, bool](numbers, names, evens)
+//
+//	assert.Equal(t, iters.NewTriple(1, "one", false), *zip.Next())
+func Zip3[A, B, C any](a iters.Iterable[A], b iters.Iterable[B], c iters.Iterable[C]) *iters.Zip3[A, B, C] {
+	return iters.NewZip3(a, b, c)
+}
+
+// Consumes an iterator of pairs, and returns two slices with the first and
+// second values of the pairs respectively.
+//
+// # Example
+//
+//	numbers := itertools.AsIter([]int{1, 2})
+//	names := itertools.AsIter([]string{"one", "two"})
+//
+//	a, b := itertools.Unzip[int, string](itertools.Zip[int, string](numbers, names))
+//
+//	assert.Equal(t, []int{1, 2}, a)
+//	assert.Equal(t, []string{"one", "two"}, b)
+func Unzip[A, B any](iter iters.Iterable[iters.Pair[A, B]]) ([]A, []B) {
+	a, b := make([]A, 0), make([]B, 0)
+
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		a = append(a, v.First)
+		b = append(b, v.Second)
+	}
+
+	return a, b
+}
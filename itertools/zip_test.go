@@ -0,0 +1,73 @@
+package itertools_test
+
+import (
+	"testing"
+
+	"github.com/skylissh/std-go/itertools"
+	"github.com/skylissh/std-go/itertools/iters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	numbers := itertools.AsIter([]int{1, 2, 3})
+	names := itertools.AsIter([]string{"one", "two"})
+	expect := []iters.Pair[int, string]{iters.NewPair(1, "one"), iters.NewPair(2, "two")}
+
+	assert.Equal(t, expect, itertools.Zip[int, string](numbers, names).Collect())
+}
+
+func TestZipFilter(t *testing.T) {
+	numbers := itertools.AsIter([]int{1, 2, 3})
+	names := itertools.AsIter([]string{"one", "two", "three"})
+	expect := []iters.Pair[int, string]{iters.NewPair(2, "two")}
+
+	assert.Equal(t, expect, itertools.Zip[int, string](numbers, names).Filter(func(p iters.Pair[int, string]) bool {
+		return p.First%2 == 0
+	}).Collect())
+}
+
+func TestZipClone(t *testing.T) {
+	numbers := itertools.AsIter([]int{1, 2})
+	names := itertools.AsIter([]string{"one", "two"})
+	zip := itertools.Zip[int, string](numbers, names)
+
+	assert.Equal(t, zip.Clone().Collect(), zip.Collect())
+}
+
+func TestZipLongest(t *testing.T) {
+	numbers := itertools.AsIter([]int{1, 2})
+	names := itertools.AsIter([]string{"one"})
+	zip := itertools.ZipLongest[int, string](numbers, names)
+
+	pair := zip.Next()
+	assert.Equal(t, 1, *pair.First)
+	assert.Equal(t, "one", *pair.Second)
+
+	pair = zip.Next()
+	assert.Equal(t, 2, *pair.First)
+	assert.Nil(t, pair.Second)
+
+	assert.Nil(t, zip.Next())
+}
+
+func TestZip3(t *testing.T) {
+	numbers := itertools.AsIter([]int{1, 2})
+	names := itertools.AsIter([]string{"one", "two"})
+	evens := itertools.AsIter([]bool{false, true, false})
+	expect := []iters.Triple[int, string, bool]{
+		iters.NewTriple(1, "one", false),
+		iters.NewTriple(2, "two", true),
+	}
+
+	assert.Equal(t, expect, itertools.Zip3[int, string, bool](numbers, names, evens).Collect())
+}
+
+func TestUnzip(t *testing.T) {
+	numbers := itertools.AsIter([]int{1, 2})
+	names := itertools.AsIter([]string{"one", "two"})
+
+	a, b := itertools.Unzip[int, string](itertools.Zip[int, string](numbers, names))
+
+	assert.Equal(t, []int{1, 2}, a)
+	assert.Equal(t, []string{"one", "two"}, b)
+}